@@ -0,0 +1,114 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// Benchmarks -- these benchmarks compare executing a statement without a where clause, which skips
+// condition evaluation entirely, against executing statements whose condition has to be evaluated.
+func BenchmarkExecuteWithoutCondition(b *testing.B) {
+	statement := benchmarkStatement(b, `testing_getsetter(name)`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = statement.Execute(nil)
+	}
+}
+
+func BenchmarkExecuteWithConstantCondition(b *testing.B) {
+	statement := benchmarkStatement(b, `testing_getsetter(name) where true`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = statement.Execute(nil)
+	}
+}
+
+func BenchmarkExecuteWithComparisonCondition(b *testing.B) {
+	statement := benchmarkStatement(b, `testing_getsetter(name) where name == nil`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = statement.Execute(nil)
+	}
+}
+
+func BenchmarkStatementsExecute(b *testing.B) {
+	statements := Statements[interface{}]{
+		benchmarkStatement(b, `testing_getsetter(name) where name == nil`),
+		benchmarkStatement(b, `testing_getsetter(name) where name != nil or 1 > 2`),
+		benchmarkStatement(b, `testing_getsetter(name) where name == nil and 1.5 <= 2`),
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = statements.Execute(nil)
+	}
+}
+
+func Test_Statement_Execute_allocations(t *testing.T) {
+	tests := []string{
+		`testing_getsetter(name)`,
+		`testing_getsetter(name) where true`,
+		`testing_getsetter(name) where name == nil`,
+		`testing_getsetter(name) where name != nil`,
+		`testing_getsetter(name) where name == nil and (1 > 2 or "a" < "b")`,
+		`testing_getsetter(name) where 2 between 1 and 3`,
+		`testing_getsetter(name) where 2.5 not between 1 and 2`,
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			p, err := NewParser[interface{}](
+				defaultFunctionsForTests(),
+				testParsePath,
+				testParseEnum,
+				componenttest.NewNopTelemetrySettings(),
+			)
+			require.NoError(t, err)
+			statements, err := p.ParseStatements([]string{tt})
+			require.NoError(t, err)
+			statement := statements[0]
+			statement.EnableCounters()
+
+			allocs := testing.AllocsPerRun(1000, func() {
+				_, _, _ = statement.Execute(nil)
+			})
+			assert.Zero(t, allocs)
+		})
+	}
+}
+
+func benchmarkStatement(b *testing.B, statement string) *Statement[interface{}] {
+	p, err := NewParser[interface{}](
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	if err != nil {
+		b.Fatal(err)
+	}
+	statements, err := p.ParseStatements([]string{statement})
+	if err != nil {
+		b.Fatal(err)
+	}
+	return statements[0]
+}
@@ -15,26 +15,30 @@
 package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 
 import (
+	"context"
 	"fmt"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlregex"
 )
 
 // boolExpressionEvaluator is a function that returns the result.
-type boolExpressionEvaluator[K any] func(ctx K) (bool, error)
+type boolExpressionEvaluator[K any] func(ctx context.Context, tCtx K) (bool, error)
 
-func alwaysTrue[K any](K) (bool, error) {
+func alwaysTrue[K any](context.Context, K) (bool, error) {
 	return true, nil
 }
 
-func alwaysFalse[K any](K) (bool, error) {
+func alwaysFalse[K any](context.Context, K) (bool, error) {
 	return false, nil
 }
 
 // builds a function that returns a short-circuited result of ANDing
 // boolExpressionEvaluator funcs
 func andFuncs[K any](funcs []boolExpressionEvaluator[K]) boolExpressionEvaluator[K] {
-	return func(ctx K) (bool, error) {
+	return func(ctx context.Context, tCtx K) (bool, error) {
 		for _, f := range funcs {
-			result, err := f(ctx)
+			result, err := f(ctx, tCtx)
 			if err != nil {
 				return false, err
 			}
@@ -49,9 +53,9 @@ func andFuncs[K any](funcs []boolExpressionEvaluator[K]) boolExpressionEvaluator
 // builds a function that returns a short-circuited result of ORing
 // boolExpressionEvaluator funcs
 func orFuncs[K any](funcs []boolExpressionEvaluator[K]) boolExpressionEvaluator[K] {
-	return func(ctx K) (bool, error) {
+	return func(ctx context.Context, tCtx K) (bool, error) {
 		for _, f := range funcs {
-			result, err := f(ctx)
+			result, err := f(ctx, tCtx)
 			if err != nil {
 				return false, err
 			}
@@ -71,18 +75,30 @@ func (p *Parser[K]) newComparisonEvaluator(comparison *comparison) (boolExpressi
 	if err != nil {
 		return nil, err
 	}
+	if comparison.Between != nil {
+		return p.newBetweenEvaluator(left, comparison.Between)
+	}
 	right, err := p.newGetter(comparison.Right)
 	if err != nil {
 		return nil, err
 	}
 
+	switch comparison.Op {
+	case CONTAINS:
+		return newStringOpEvaluator(comparison.Op, left, right, func(a, b string) (bool, error) {
+			return strings.Contains(a, b), nil
+		}), nil
+	case MATCHES, NOT_MATCHES:
+		return newMatchesEvaluator(comparison.Op, left, right, comparison.Right)
+	}
+
 	// The parser ensures that we'll never get an invalid comparison.Op, so we don't have to check that case.
-	return func(ctx K) (bool, error) {
-		a, leftErr := left.Get(ctx)
+	return func(ctx context.Context, tCtx K) (bool, error) {
+		a, leftErr := getContext(ctx, left, tCtx)
 		if leftErr != nil {
 			return false, leftErr
 		}
-		b, rightErr := right.Get(ctx)
+		b, rightErr := getContext(ctx, right, tCtx)
 		if rightErr != nil {
 			return false, rightErr
 		}
@@ -91,6 +107,102 @@ func (p *Parser[K]) newComparisonEvaluator(comparison *comparison) (boolExpressi
 
 }
 
+// newStringOpEvaluator builds an evaluator for operators that are only defined for strings,
+// returning an error if either operand is not a string.
+func newStringOpEvaluator[K any](op compareOp, left Getter[K], right Getter[K], f func(a, b string) (bool, error)) boolExpressionEvaluator[K] {
+	return func(ctx context.Context, tCtx K) (bool, error) {
+		a, err := getContext(ctx, left, tCtx)
+		if err != nil {
+			return false, err
+		}
+		b, err := getContext(ctx, right, tCtx)
+		if err != nil {
+			return false, err
+		}
+		aStr, aOk := a.(string)
+		bStr, bOk := b.(string)
+		if !aOk || !bOk {
+			return false, fmt.Errorf("the %s operator requires string operands, got %T and %T", op.symbol(), a, b)
+		}
+		return f(aStr, bStr)
+	}
+}
+
+// newInvocationEvaluator builds an evaluator for an invocation that is used as a boolean value. The
+// invoked function must return a bool.
+func (p *Parser[K]) newInvocationEvaluator(inv *invocation) (boolExpressionEvaluator[K], error) {
+	getter, err := p.newGetter(value{Invocation: inv})
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, tCtx K) (bool, error) {
+		result, err := getContext(ctx, getter, tCtx)
+		if err != nil {
+			return false, err
+		}
+		b, ok := result.(bool)
+		if !ok {
+			return false, fmt.Errorf("the function %v used as a condition must return a bool, got %T", inv.Function, result)
+		}
+		return b, nil
+	}, nil
+}
+
+// newMatchesEvaluator builds an evaluator for the matches and !~ operators, the latter negating the
+// result of the former. If the pattern is a string literal, it is compiled once when the statement is
+// parsed; otherwise it is compiled on each evaluation.
+func newMatchesEvaluator[K any](op compareOp, left Getter[K], right Getter[K], pattern value) (boolExpressionEvaluator[K], error) {
+	negate := op == NOT_MATCHES
+	if pattern.String != nil {
+		compiled, err := ottlregex.Compile(*pattern.String)
+		if err != nil {
+			return nil, fmt.Errorf("the pattern supplied to %s is not a valid regexp: %w", op.symbol(), err)
+		}
+		return newStringOpEvaluator(op, left, right, func(a, _ string) (bool, error) {
+			return compiled.MatchString(a) != negate, nil
+		}), nil
+	}
+	return newStringOpEvaluator(op, left, right, func(a, b string) (bool, error) {
+		compiled, err := ottlregex.Compile(b)
+		if err != nil {
+			return false, fmt.Errorf("the pattern supplied to %s is not a valid regexp: %w", op.symbol(), err)
+		}
+		return compiled.MatchString(a) != negate, nil
+	}), nil
+}
+
+// newBetweenEvaluator builds an evaluator that checks whether the value of left is within the inclusive range
+// of r, using the same numeric promotion as the other comparisons. Non-numeric operands are an error.
+func (p *Parser[K]) newBetweenEvaluator(left Getter[K], r *between) (boolExpressionEvaluator[K], error) {
+	low, err := p.newGetter(r.Low)
+	if err != nil {
+		return nil, err
+	}
+	high, err := p.newGetter(r.High)
+	if err != nil {
+		return nil, err
+	}
+
+	getters := [3]Getter[K]{left, low, high}
+	return func(ctx context.Context, tCtx K) (bool, error) {
+		operands := [3]any{}
+		for i, getter := range getters {
+			val, err := getContext(ctx, getter, tCtx)
+			if err != nil {
+				return false, err
+			}
+			switch val.(type) {
+			case int64, float64:
+				operands[i] = val
+			default:
+				return false, fmt.Errorf("the between operator requires numeric operands, got %T", val)
+			}
+		}
+		in := p.compare(operands[0], operands[1], GTE) && p.compare(operands[0], operands[2], LTE)
+		return in != r.Not, nil
+	}, nil
+}
+
 func (p *Parser[K]) newBooleanExpressionEvaluator(expr *booleanExpression) (boolExpressionEvaluator[K], error) {
 	if expr == nil {
 		return alwaysTrue[K], nil
@@ -142,6 +254,8 @@ func (p *Parser[K]) newBooleanValueEvaluator(value *booleanValue) (boolExpressio
 			return nil, err
 		}
 		return comparison, nil
+	case value.Invocation != nil:
+		return p.newInvocationEvaluator(value.Invocation)
 	case value.ConstExpr != nil:
 		if *value.ConstExpr {
 			return alwaysTrue[K], nil
@@ -15,10 +15,12 @@
 package ottl
 
 import (
+	"context"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/component/componenttest"
 
@@ -78,12 +80,13 @@ func comparisonHelper(left any, right any, op string) *comparison {
 }
 
 func Test_newComparisonEvaluator(t *testing.T) {
-	p := NewParser(
+	p, err := NewParser(
 		defaultFunctionsForTests(),
 		testParsePath,
 		testParseEnum,
 		componenttest.NewNopTelemetrySettings(),
 	)
+	require.NoError(t, err)
 
 	var tests = []struct {
 		name string
@@ -113,15 +116,32 @@ func Test_newComparisonEvaluator(t *testing.T) {
 		{name: "not true > 0", l: true, r: 0, op: ">"},
 		{name: "not 'true' == true", l: "true", r: true, op: "=="},
 		{name: "[]byte('a') < []byte('b')", l: []byte("a"), r: []byte("b"), op: "<", want: true},
+		{name: "[]byte('a') == []byte('a')", l: []byte("a"), r: []byte("a"), op: "==", want: true},
+		{name: "not []byte('a') == []byte('b')", l: []byte("a"), r: []byte("b"), op: "=="},
+		{name: "[]byte('a') != []byte('b')", l: []byte("a"), r: []byte("b"), op: "!=", want: true},
+		{name: "[]byte('ab') > []byte('a')", l: []byte("ab"), r: []byte("a"), op: ">", want: true},
+		{name: "[]byte('b') >= []byte('ab')", l: []byte("b"), r: []byte("ab"), op: ">=", want: true},
 		{name: "nil == nil", op: "==", want: true},
 		{name: "nil == []byte(nil)", r: []byte(nil), op: "==", want: true},
+		{name: "'healthcheck' contains 'health'", l: "healthcheck", r: "health", op: "contains", want: true},
+		{name: "not 'healthcheck' contains 'alive'", l: "healthcheck", r: "alive", op: "contains"},
+		{name: "bear contains 'ea'", l: "NAME", r: "ea", op: "contains", item: "bear", want: true},
+		{name: "'healthcheck' matches '^health'", l: "healthcheck", r: "^health", op: "matches", want: true},
+		{name: "not 'healthcheck' matches 'alive$'", l: "healthcheck", r: "alive$", op: "matches"},
+		{name: "'bear' matches pattern from path", l: "bear", r: "NAME", op: "matches", item: "^b.a", want: true},
+		{name: "not 'bear' matches pattern from path", l: "bear", r: "NAME", op: "matches", item: "^c"},
+		{name: "'BEAR' matches case insensitive pattern", l: "BEAR", r: "(?i)^b.a", op: "matches", want: true},
+		{name: "'healthcheck' !~ 'alive$'", l: "healthcheck", r: "alive$", op: "!~", want: true},
+		{name: "not 'healthcheck' !~ '^health'", l: "healthcheck", r: "^health", op: "!~"},
+		{name: "'bear' !~ pattern from path", l: "bear", r: "NAME", op: "!~", item: "^c", want: true},
+		{name: "not 'bear' !~ pattern from path", l: "bear", r: "NAME", op: "!~", item: "^b.a"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			comp := comparisonHelper(tt.l, tt.r, tt.op)
 			evaluate, err := p.newComparisonEvaluator(comp)
 			assert.NoError(t, err)
-			result, err := evaluate(tt.item)
+			result, err := evaluate(context.Background(), tt.item)
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, result)
 		})
@@ -129,12 +149,13 @@ func Test_newComparisonEvaluator(t *testing.T) {
 }
 
 func Test_newConditionEvaluator_invalid(t *testing.T) {
-	p := NewParser(
+	p, err := NewParser(
 		defaultFunctionsForTests(),
 		testParsePath,
 		testParseEnum,
 		component.TelemetrySettings{},
 	)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name       string
@@ -152,6 +173,14 @@ func Test_newConditionEvaluator_invalid(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:       "invalid matches pattern",
+			comparison: comparisonHelper("NAME", "(unclosed", "matches"),
+		},
+		{
+			name:       "invalid !~ pattern",
+			comparison: comparisonHelper("NAME", "(unclosed", "!~"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -161,13 +190,231 @@ func Test_newConditionEvaluator_invalid(t *testing.T) {
 	}
 }
 
+func Test_newComparisonEvaluator_enum(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		condition string
+		item      int64
+		want      bool
+	}{
+		{condition: `name == TEST_ENUM_TWO`, item: 2, want: true},
+		{condition: `name == TEST_ENUM_TWO`, item: 1, want: false},
+		{condition: `TEST_ENUM_ONE != name`, item: 2, want: true},
+		{condition: `name > TEST_ENUM`, item: 1, want: true},
+		{condition: `name between TEST_ENUM_ONE and TEST_ENUM_TWO`, item: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			statements, err := p.ParseStatements([]string{`testing_getsetter(name) where ` + tt.condition})
+			require.NoError(t, err)
+			result, err := statements[0].Condition(tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_newComparisonEvaluator_invocation(t *testing.T) {
+	functions := defaultFunctionsForTests()
+	functions["Len"] = functionWithLen
+	p, err := NewParser(
+		functions,
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		condition string
+		item      string
+		want      bool
+	}{
+		{condition: `Len(name) > 0`, item: "bear", want: true},
+		{condition: `Len(name) > 0`, item: "", want: false},
+		{condition: `4 == Len(name)`, item: "bear", want: true},
+		{condition: `3 == Len(name)`, item: "bear", want: false},
+		{condition: `Len(name) == Len("lion")`, item: "bear", want: true},
+		{condition: `Len(name) < Len("cat")`, item: "bear", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			statements, err := p.ParseStatements([]string{`testing_getsetter(name) where ` + tt.condition})
+			require.NoError(t, err)
+			result, err := statements[0].Condition(tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_newBooleanValueEvaluator_invocation(t *testing.T) {
+	functions := defaultFunctionsForTests()
+	functions["Len"] = functionWithLen
+	functions["IsBear"] = functionWithIsBear
+	p, err := NewParser(
+		functions,
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		condition string
+		item      string
+		want      bool
+	}{
+		{condition: `IsBear(name)`, item: "bear", want: true},
+		{condition: `IsBear(name)`, item: "lion", want: false},
+		{condition: `IsBear(name) and Len(name) == 4`, item: "bear", want: true},
+		{condition: `false or IsBear(name)`, item: "bear", want: true},
+		{condition: `(IsBear(name) or Len(name) > 4) and true`, item: "tiger", want: true},
+		{condition: `IsBear(name) == false`, item: "lion", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			statements, err := p.ParseStatements([]string{`testing_getsetter(name) where ` + tt.condition})
+			require.NoError(t, err)
+			result, err := statements[0].Condition(tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+
+	statements, err := p.ParseStatements([]string{`testing_getsetter(name) where Len(name)`})
+	require.NoError(t, err)
+	_, err = statements[0].Condition("bear")
+	assert.EqualError(t, err, "the function Len used as a condition must return a bool, got int64")
+}
+
+func Test_newBooleanExpressionEvaluator_shortCircuit(t *testing.T) {
+	tests := []struct {
+		condition     string
+		item          string
+		want          bool
+		expectedCalls int
+	}{
+		{condition: `false and Expensive()`, want: false, expectedCalls: 0},
+		{condition: `true or Expensive()`, want: true, expectedCalls: 0},
+		{condition: `name == "a" and Expensive()`, item: "b", want: false, expectedCalls: 0},
+		{condition: `name == "a" or Expensive() == false`, item: "a", want: true, expectedCalls: 0},
+		{condition: `(false and Expensive()) or (true or Expensive())`, want: true, expectedCalls: 0},
+		{condition: `false and Expensive() or true`, want: true, expectedCalls: 0},
+		{condition: `true and Expensive()`, want: true, expectedCalls: 1},
+		{condition: `false or Expensive()`, want: true, expectedCalls: 1},
+		{condition: `Expensive() and Expensive() and false`, want: false, expectedCalls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			calls := 0
+			functions := defaultFunctionsForTests()
+			functions["Expensive"] = func() (ExprFunc[interface{}], error) {
+				return func(interface{}) (interface{}, error) {
+					calls++
+					return true, nil
+				}, nil
+			}
+			p, err := NewParser(
+				functions,
+				testParsePath,
+				testParseEnum,
+				componenttest.NewNopTelemetrySettings(),
+			)
+			require.NoError(t, err)
+
+			statements, err := p.ParseStatements([]string{`testing_getsetter(name) where ` + tt.condition})
+			require.NoError(t, err)
+			result, err := statements[0].Condition(tt.item)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func functionWithIsBear(target Getter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(ctx interface{}) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return val == "bear", nil
+	}, nil
+}
+
+func functionWithLen(target Getter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(ctx interface{}) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return int64(len(val.(string))), nil
+	}, nil
+}
+
+func Test_newComparisonEvaluator_unknownEnum(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	_, err = p.ParseStatements([]string{`testing_getsetter(name) where name == SYMBOL_NOT_FOUND`})
+	assert.EqualError(t, err, "enum symbol not found")
+}
+
+func Test_newComparisonEvaluator_stringOperatorErrors(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		l       any
+		r       any
+		op      string
+		item    string
+		wantErr string
+	}{
+		{name: "contains int", l: 1, r: "1", op: "contains", wantErr: "the contains operator requires string operands, got int64 and string"},
+		{name: "contains nil", l: "hello", op: "contains", wantErr: "the contains operator requires string operands, got string and <nil>"},
+		{name: "matches bool", l: true, r: "^t", op: "matches", wantErr: "the matches operator requires string operands, got bool and string"},
+		{name: "matches invalid pattern from path", l: "bear", r: "NAME", op: "matches", item: "(unclosed", wantErr: "the pattern supplied to matches is not a valid regexp"},
+		{name: "!~ int", l: 1, r: "^1", op: "!~", wantErr: "the !~ operator requires string operands, got int64 and string"},
+		{name: "!~ invalid pattern from path", l: "bear", r: "NAME", op: "!~", item: "(unclosed", wantErr: "the pattern supplied to !~ is not a valid regexp"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluate, err := p.newComparisonEvaluator(comparisonHelper(tt.l, tt.r, tt.op))
+			assert.NoError(t, err)
+			_, err = evaluate(context.Background(), tt.item)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
 func Test_newBooleanExpressionEvaluator(t *testing.T) {
-	p := NewParser(
+	p, err := NewParser(
 		defaultFunctionsForTests(),
 		testParsePath,
 		testParseEnum,
 		component.TelemetrySettings{},
 	)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name string
@@ -353,7 +600,98 @@ func Test_newBooleanExpressionEvaluator(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			evaluate, err := p.newBooleanExpressionEvaluator(tt.expr)
 			assert.NoError(t, err)
-			result, err := evaluate(nil)
+			result, err := evaluate(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+// Test_newBooleanExpressionEvaluator_precedence checks that "and" binds tighter than "or"
+// for conditions written without parentheses.
+func Test_newBooleanExpressionEvaluator_precedence(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		// (true) or (false and false); evaluating left to right would give false.
+		{`true or false and false`, true},
+		// (false and true) or (true); evaluating right to left would give false.
+		{`false and true or true`, true},
+		{`false and true or false and true`, false},
+		{`true and false or true and true`, true},
+		{`false or true and false or false`, false},
+		{`false or true and true or false`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			parsed, err := parseStatement(`set(name, "test") where ` + tt.condition)
+			assert.NoError(t, err)
+			evaluate, err := p.newBooleanExpressionEvaluator(parsed.WhereClause)
+			assert.NoError(t, err)
+			result, err := evaluate(context.Background(), nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func Test_newComparisonEvaluator_between(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		l       any
+		low     any
+		high    any
+		not     bool
+		want    bool
+		wantErr string
+	}{
+		{name: "within", l: 300, low: 100, high: 500, want: true},
+		{name: "low boundary", l: 100, low: 100, high: 500, want: true},
+		{name: "high boundary", l: 500, low: 100, high: 500, want: true},
+		{name: "below", l: 99, low: 100, high: 500},
+		{name: "above", l: 501, low: 100, high: 500},
+		{name: "float within int range", l: 100.5, low: 100, high: 500, want: true},
+		{name: "int within float range", l: 2, low: 1.5, high: 2.5, want: true},
+		{name: "float above float range", l: 2.6, low: 1.5, high: 2.5},
+		{name: "not between within", l: 300, low: 100, high: 500, not: true},
+		{name: "not between boundary", l: 500, low: 100, high: 500, not: true},
+		{name: "not between above", l: 501, low: 100, high: 500, not: true, want: true},
+		{name: "string operand", l: "300", low: 100, high: 500, wantErr: "the between operator requires numeric operands, got string"},
+		{name: "nil bound", l: 300, low: 100, high: nil, wantErr: "the between operator requires numeric operands, got <nil>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluate, err := p.newComparisonEvaluator(&comparison{
+				Left: valueFor(tt.l),
+				Between: &between{
+					Not:  tt.not,
+					Low:  valueFor(tt.low),
+					High: valueFor(tt.high),
+				},
+			})
+			assert.NoError(t, err)
+			result, err := evaluate(context.Background(), nil)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
 			assert.NoError(t, err)
 			assert.Equal(t, tt.want, result)
 		})
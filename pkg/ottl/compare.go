@@ -17,6 +17,7 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"bytes"
 
+	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.uber.org/zap"
 	"golang.org/x/exp/constraints"
 )
@@ -24,6 +25,7 @@ import (
 // The functions in this file implement a general-purpose comparison of two
 // values of type any, which for the purposes of OTTL mean values that are one of
 // int, float, string, bool, or pointers to those, or []byte, or nil.
+// Span and trace IDs are compared as []byte, so that they can be compared to Bytes literals.
 
 // invalidComparison returns false for everything except NE (where it returns true to indicate that the
 // objects were definitely not equivalent).
@@ -119,6 +121,10 @@ func (p *Parser[K]) compareByte(a []byte, b any, op compareOp) bool {
 			return op == NE
 		}
 		return compareBytes(a, v, op)
+	case pcommon.SpanID:
+		return compareBytes(a, v[:], op)
+	case pcommon.TraceID:
+		return compareBytes(a, v[:], op)
 	default:
 		return p.invalidComparison("Bytes to non-Bytes", op)
 	}
@@ -173,6 +179,10 @@ func (p *Parser[K]) compare(a any, b any, op compareOp) bool {
 			return p.compare(b, nil, op)
 		}
 		return p.compareByte(v, b, op)
+	case pcommon.SpanID:
+		return p.compareByte(v[:], b, op)
+	case pcommon.TraceID:
+		return p.compareByte(v[:], b, op)
 	default:
 		// If we don't know what type it is, we can't do inequalities yet. So we can fall back to the old behavior where we just
 		// use Go's standard equality.
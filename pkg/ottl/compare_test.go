@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 // Our types are bool, int, float, string, Bytes, nil, so we compare all types in both directions.
@@ -31,6 +33,9 @@ var (
 	ba   = []byte("1")
 	bb   = []byte("2")
 	bn   []byte
+	ida  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 8})
+	idb  = pcommon.SpanID([8]byte{1, 2, 3, 4, 5, 6, 7, 9})
+	tida = pcommon.TraceID([16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
 	i64a = int64(1)
 	i64b = int64(2)
 	f64a = float64(1)
@@ -76,6 +81,16 @@ func Test_compare(t *testing.T) {
 		{"bytes float64", ba, f64a, []bool{false, true, false, false, false, false}},
 		{"bytes nil", ba, nil, []bool{false, true, false, false, false, false}},
 		{"bytes nilbytes", ba, bn, []bool{false, true, false, false, false, false}},
+		{"bytes empty bytes", ba, []byte{}, []bool{false, true, false, false, true, true}},
+		{"bytes prefix", []byte("1"), []byte("12"), []bool{false, true, true, true, false, false}},
+		{"identity span id", ida, ida, []bool{true, false, false, true, true, false}},
+		{"diff span id", ida, idb, []bool{false, true, true, true, false, false}},
+		{"span id bytes", ida, []byte{1, 2, 3, 4, 5, 6, 7, 8}, []bool{true, false, false, true, true, false}},
+		{"bytes span id", []byte{1, 2, 3, 4, 5, 6, 7, 9}, ida, []bool{false, true, false, false, true, true}},
+		{"trace id bytes", tida, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, []bool{true, false, false, true, true, false}},
+		{"span id trace id", ida, tida, []bool{false, true, true, true, false, false}},
+		{"span id nil", ida, nil, []bool{false, true, false, false, false, false}},
+		{"span id string", ida, sa, []bool{false, true, false, false, false, false}},
 
 		{"false true", ta, tb, []bool{false, true, true, true, false, false}},
 		{"true false", tb, ta, []bool{false, true, false, false, true, true}},
@@ -116,7 +131,8 @@ func Test_compare(t *testing.T) {
 	for _, tt := range tests {
 		for _, op := range ops {
 			t.Run(fmt.Sprintf("%s %v", tt.name, op), func(t *testing.T) {
-				p := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+				p, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+				require.NoError(t, err)
 				if got := p.compare(tt.a, tt.b, op); got != tt.want[op] {
 					t.Errorf("compare(%v, %v, %v) = %v, want %v", tt.a, tt.b, op, got, tt.want[op])
 				}
@@ -130,7 +146,8 @@ func Test_compare(t *testing.T) {
 // The summary is that they're pretty fast; all the calls to compare are 12 ns/op or less on a 2019 intel
 // mac pro laptop, and none of them have any allocations.
 func BenchmarkCompareEQInt64(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -139,7 +156,8 @@ func BenchmarkCompareEQInt64(b *testing.B) {
 }
 
 func BenchmarkCompareEQFloat(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -148,7 +166,8 @@ func BenchmarkCompareEQFloat(b *testing.B) {
 }
 
 func BenchmarkCompareEQString(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -157,7 +176,8 @@ func BenchmarkCompareEQString(b *testing.B) {
 }
 
 func BenchmarkCompareEQPString(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -166,7 +186,8 @@ func BenchmarkCompareEQPString(b *testing.B) {
 }
 
 func BenchmarkCompareEQBytes(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -175,7 +196,8 @@ func BenchmarkCompareEQBytes(b *testing.B) {
 }
 
 func BenchmarkCompareEQNil(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -184,7 +206,8 @@ func BenchmarkCompareEQNil(b *testing.B) {
 }
 
 func BenchmarkCompareNEInt(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -193,7 +216,8 @@ func BenchmarkCompareNEInt(b *testing.B) {
 }
 
 func BenchmarkCompareNEFloat(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -202,7 +226,8 @@ func BenchmarkCompareNEFloat(b *testing.B) {
 }
 
 func BenchmarkCompareNEString(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -211,7 +236,8 @@ func BenchmarkCompareNEString(b *testing.B) {
 }
 
 func BenchmarkCompareLTFloat(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -220,7 +246,8 @@ func BenchmarkCompareLTFloat(b *testing.B) {
 }
 
 func BenchmarkCompareLTString(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -229,7 +256,8 @@ func BenchmarkCompareLTString(b *testing.B) {
 }
 
 func BenchmarkCompareLTNil(b *testing.B) {
-	testParser := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	testParser, err := NewParser[interface{}](nil, nil, nil, componenttest.NewNopTelemetrySettings())
+	require.NoError(b, err)
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlcommon // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/internal/ottlcommon"
+
+import (
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// The functions in this file build GetSetters for fields that are shared by several signals,
+// so that contexts only have to supply how the field is read from and written to their telemetry.
+
+// AttributesGetSetter returns a GetSetter for the pcommon.Map returned by getMap.
+// If mapKey is not nil, the GetSetter accesses the value of that key instead of the whole map.
+func AttributesGetSetter[K any](getMap func(K) pcommon.Map, mapKey *string) ottl.StandardGetSetter[K] {
+	if mapKey != nil {
+		return ottl.StandardGetSetter[K]{
+			Getter: func(ctx K) (interface{}, error) {
+				return GetMapValue(getMap(ctx), *mapKey), nil
+			},
+			Setter: func(ctx K, val interface{}) error {
+				SetMapValue(getMap(ctx), *mapKey, val)
+				return nil
+			},
+		}
+	}
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx K) (interface{}, error) {
+			return getMap(ctx), nil
+		},
+		Setter: func(ctx K, val interface{}) error {
+			if attrs, ok := val.(pcommon.Map); ok {
+				attrs.CopyTo(getMap(ctx))
+			}
+			return nil
+		},
+	}
+}
+
+// StringGetSetter returns a GetSetter for a string field. Values that are not strings are ignored when setting.
+func StringGetSetter[K any](get func(K) string, set func(K, string)) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx K) (interface{}, error) {
+			return get(ctx), nil
+		},
+		Setter: func(ctx K, val interface{}) error {
+			if s, ok := val.(string); ok {
+				set(ctx, s)
+			}
+			return nil
+		},
+	}
+}
+
+// TimestampGetSetter returns a GetSetter for a pcommon.Timestamp field, which is exposed as nanoseconds
+// since the Unix epoch. Values that are not int64 are ignored when setting.
+func TimestampGetSetter[K any](get func(K) pcommon.Timestamp, set func(K, pcommon.Timestamp)) ottl.StandardGetSetter[K] {
+	return ottl.StandardGetSetter[K]{
+		Getter: func(ctx K) (interface{}, error) {
+			return get(ctx).AsTime().UnixNano(), nil
+		},
+		Setter: func(ctx K, val interface{}) error {
+			if i, ok := val.(int64); ok {
+				set(ctx, pcommon.NewTimestampFromTime(time.Unix(0, i)))
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlcommon
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
+)
+
+// mapContext is a minimal context whose fields are resolved entirely through the shared builders.
+type mapContext struct {
+	attributes pcommon.Map
+	name       string
+	time       pcommon.Timestamp
+}
+
+func mapContextPathGetSetter(path []ottl.Field) (ottl.GetSetter[*mapContext], error) {
+	switch path[0].Name {
+	case "attributes":
+		return AttributesGetSetter(func(ctx *mapContext) pcommon.Map {
+			return ctx.attributes
+		}, path[0].MapKey), nil
+	case "name":
+		return StringGetSetter(func(ctx *mapContext) string {
+			return ctx.name
+		}, func(ctx *mapContext, name string) {
+			ctx.name = name
+		}), nil
+	case "time_unix_nano":
+		return TimestampGetSetter(func(ctx *mapContext) pcommon.Timestamp {
+			return ctx.time
+		}, func(ctx *mapContext, ts pcommon.Timestamp) {
+			ctx.time = ts
+		}), nil
+	}
+	return nil, fmt.Errorf("invalid path expression %v", path)
+}
+
+func newMapContext() *mapContext {
+	attrs := pcommon.NewMap()
+	attrs.PutStr("x", "val")
+	return &mapContext{
+		attributes: attrs,
+		name:       "operation",
+		time:       pcommon.NewTimestampFromTime(time.UnixMilli(100)),
+	}
+}
+
+func TestSharedGetSetters(t *testing.T) {
+	newAttrs := pcommon.NewMap()
+	newAttrs.PutStr("hello", "world")
+
+	newSlice := pcommon.NewSlice()
+	newSlice.AppendEmpty().SetStr("a")
+	newSlice.AppendEmpty().SetInt(1)
+
+	tests := []struct {
+		name     string
+		path     []ottl.Field
+		orig     interface{}
+		newVal   interface{}
+		modified func(ctx *mapContext)
+	}{
+		{
+			name: "attributes",
+			path: []ottl.Field{
+				{
+					Name: "attributes",
+				},
+			},
+			orig:   newMapContext().attributes,
+			newVal: newAttrs,
+			modified: func(ctx *mapContext) {
+				newAttrs.CopyTo(ctx.attributes)
+			},
+		},
+		{
+			name: "attributes key",
+			path: []ottl.Field{
+				{
+					Name:   "attributes",
+					MapKey: ottltest.Strp("x"),
+				},
+			},
+			orig:   "val",
+			newVal: "newVal",
+			modified: func(ctx *mapContext) {
+				ctx.attributes.PutStr("x", "newVal")
+			},
+		},
+		{
+			name: "attributes new key",
+			path: []ottl.Field{
+				{
+					Name:   "attributes",
+					MapKey: ottltest.Strp("y"),
+				},
+			},
+			orig:   nil,
+			newVal: int64(10),
+			modified: func(ctx *mapContext) {
+				ctx.attributes.PutInt("y", 10)
+			},
+		},
+		{
+			name: "attributes key slice",
+			path: []ottl.Field{
+				{
+					Name:   "attributes",
+					MapKey: ottltest.Strp("y"),
+				},
+			},
+			orig:   nil,
+			newVal: newSlice,
+			modified: func(ctx *mapContext) {
+				newSlice.CopyTo(ctx.attributes.PutEmptySlice("y"))
+			},
+		},
+		{
+			name: "name",
+			path: []ottl.Field{
+				{
+					Name: "name",
+				},
+			},
+			orig:   "operation",
+			newVal: "newName",
+			modified: func(ctx *mapContext) {
+				ctx.name = "newName"
+			},
+		},
+		{
+			name: "name ignores other types",
+			path: []ottl.Field{
+				{
+					Name: "name",
+				},
+			},
+			orig:     "operation",
+			newVal:   int64(1),
+			modified: func(ctx *mapContext) {},
+		},
+		{
+			name: "time_unix_nano",
+			path: []ottl.Field{
+				{
+					Name: "time_unix_nano",
+				},
+			},
+			orig:   int64(100_000_000),
+			newVal: int64(200_000_000),
+			modified: func(ctx *mapContext) {
+				ctx.time = pcommon.NewTimestampFromTime(time.UnixMilli(200))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accessor, err := mapContextPathGetSetter(tt.path)
+			assert.NoError(t, err)
+
+			ctx := newMapContext()
+
+			got, err := accessor.Get(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.orig, got)
+
+			err = accessor.Set(ctx, tt.newVal)
+			assert.NoError(t, err)
+
+			expected := newMapContext()
+			tt.modified(expected)
+
+			assert.Equal(t, expected, ctx)
+		})
+	}
+}
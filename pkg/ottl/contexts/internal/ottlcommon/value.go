@@ -16,76 +16,15 @@ package ottlcommon // import "github.com/open-telemetry/opentelemetry-collector-
 
 import (
 	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 )
 
 func GetValue(val pcommon.Value) interface{} {
-	switch val.Type() {
-	case pcommon.ValueTypeStr:
-		return val.Str()
-	case pcommon.ValueTypeBool:
-		return val.Bool()
-	case pcommon.ValueTypeInt:
-		return val.Int()
-	case pcommon.ValueTypeDouble:
-		return val.Double()
-	case pcommon.ValueTypeMap:
-		return val.Map()
-	case pcommon.ValueTypeSlice:
-		return val.Slice()
-	case pcommon.ValueTypeBytes:
-		return val.Bytes().AsRaw()
-	}
-	return nil
+	return ottl.GetValue(val)
 }
 
+// SetValue sets value to val. Values of unsupported types are ignored.
 func SetValue(value pcommon.Value, val interface{}) {
-	switch v := val.(type) {
-	case string:
-		value.SetStr(v)
-	case bool:
-		value.SetBool(v)
-	case int64:
-		value.SetInt(v)
-	case float64:
-		value.SetDouble(v)
-	case []byte:
-		value.SetEmptyBytes().FromRaw(v)
-	case []string:
-		value.Slice().RemoveIf(func(_ pcommon.Value) bool {
-			return true
-		})
-		for _, str := range v {
-			value.Slice().AppendEmpty().SetStr(str)
-		}
-	case []bool:
-		value.Slice().RemoveIf(func(_ pcommon.Value) bool {
-			return true
-		})
-		for _, b := range v {
-			value.Slice().AppendEmpty().SetBool(b)
-		}
-	case []int64:
-		value.Slice().RemoveIf(func(_ pcommon.Value) bool {
-			return true
-		})
-		for _, i := range v {
-			value.Slice().AppendEmpty().SetInt(i)
-		}
-	case []float64:
-		value.Slice().RemoveIf(func(_ pcommon.Value) bool {
-			return true
-		})
-		for _, f := range v {
-			value.Slice().AppendEmpty().SetDouble(f)
-		}
-	case [][]byte:
-		value.Slice().RemoveIf(func(_ pcommon.Value) bool {
-			return true
-		})
-		for _, b := range v {
-			value.Slice().AppendEmpty().SetEmptyBytes().FromRaw(b)
-		}
-	default:
-		// TODO(anuraaga): Support set of map type.
-	}
+	_ = ottl.SetValue(value, val)
 }
@@ -18,7 +18,6 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
@@ -57,8 +56,8 @@ func (ctx TransformContext) GetResource() pcommon.Resource {
 	return ctx.resource
 }
 
-func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings) ottl.Parser[TransformContext] {
-	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings)
+func NewParser(functions map[string]interface{}, telemetrySettings component.TelemetrySettings, options ...ottl.Option[TransformContext]) (ottl.Parser[TransformContext], error) {
+	return ottl.NewParser[TransformContext](functions, parsePath, parseEnum, telemetrySettings, options...)
 }
 
 var symbolTable = map[ottl.EnumSymbol]ottl.Enum{
@@ -113,21 +112,31 @@ func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], erro
 	case "instrumentation_scope":
 		return ottlcommon.ScopePathGetSetter[TransformContext](path[1:])
 	case "time_unix_nano":
-		return accessTimeUnixNano(), nil
+		return ottlcommon.TimestampGetSetter(func(ctx TransformContext) pcommon.Timestamp {
+			return ctx.GetLogRecord().Timestamp()
+		}, func(ctx TransformContext, ts pcommon.Timestamp) {
+			ctx.GetLogRecord().SetTimestamp(ts)
+		}), nil
 	case "observed_time_unix_nano":
-		return accessObservedTimeUnixNano(), nil
+		return ottlcommon.TimestampGetSetter(func(ctx TransformContext) pcommon.Timestamp {
+			return ctx.GetLogRecord().ObservedTimestamp()
+		}, func(ctx TransformContext, ts pcommon.Timestamp) {
+			ctx.GetLogRecord().SetObservedTimestamp(ts)
+		}), nil
 	case "severity_number":
 		return accessSeverityNumber(), nil
 	case "severity_text":
-		return accessSeverityText(), nil
+		return ottlcommon.StringGetSetter(func(ctx TransformContext) string {
+			return ctx.GetLogRecord().SeverityText()
+		}, func(ctx TransformContext, text string) {
+			ctx.GetLogRecord().SetSeverityText(text)
+		}), nil
 	case "body":
 		return accessBody(), nil
 	case "attributes":
-		mapKey := path[0].MapKey
-		if mapKey == nil {
-			return accessAttributes(), nil
-		}
-		return accessAttributesKey(mapKey), nil
+		return ottlcommon.AttributesGetSetter(func(ctx TransformContext) pcommon.Map {
+			return ctx.GetLogRecord().Attributes()
+		}, path[0].MapKey), nil
 	case "dropped_attributes_count":
 		return accessDroppedAttributesCount(), nil
 	case "flags":
@@ -151,34 +160,6 @@ func newPathGetSetter(path []ottl.Field) (ottl.GetSetter[TransformContext], erro
 	return nil, fmt.Errorf("invalid path expression %v", path)
 }
 
-func accessTimeUnixNano() ottl.StandardGetSetter[TransformContext] {
-	return ottl.StandardGetSetter[TransformContext]{
-		Getter: func(ctx TransformContext) (interface{}, error) {
-			return ctx.GetLogRecord().Timestamp().AsTime().UnixNano(), nil
-		},
-		Setter: func(ctx TransformContext, val interface{}) error {
-			if i, ok := val.(int64); ok {
-				ctx.GetLogRecord().SetTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
-			}
-			return nil
-		},
-	}
-}
-
-func accessObservedTimeUnixNano() ottl.StandardGetSetter[TransformContext] {
-	return ottl.StandardGetSetter[TransformContext]{
-		Getter: func(ctx TransformContext) (interface{}, error) {
-			return ctx.GetLogRecord().ObservedTimestamp().AsTime().UnixNano(), nil
-		},
-		Setter: func(ctx TransformContext, val interface{}) error {
-			if i, ok := val.(int64); ok {
-				ctx.GetLogRecord().SetObservedTimestamp(pcommon.NewTimestampFromTime(time.Unix(0, i)))
-			}
-			return nil
-		},
-	}
-}
-
 func accessSeverityNumber() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx TransformContext) (interface{}, error) {
@@ -193,20 +174,6 @@ func accessSeverityNumber() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
-func accessSeverityText() ottl.StandardGetSetter[TransformContext] {
-	return ottl.StandardGetSetter[TransformContext]{
-		Getter: func(ctx TransformContext) (interface{}, error) {
-			return ctx.GetLogRecord().SeverityText(), nil
-		},
-		Setter: func(ctx TransformContext, val interface{}) error {
-			if s, ok := val.(string); ok {
-				ctx.GetLogRecord().SetSeverityText(s)
-			}
-			return nil
-		},
-	}
-}
-
 func accessBody() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx TransformContext) (interface{}, error) {
@@ -219,32 +186,6 @@ func accessBody() ottl.StandardGetSetter[TransformContext] {
 	}
 }
 
-func accessAttributes() ottl.StandardGetSetter[TransformContext] {
-	return ottl.StandardGetSetter[TransformContext]{
-		Getter: func(ctx TransformContext) (interface{}, error) {
-			return ctx.GetLogRecord().Attributes(), nil
-		},
-		Setter: func(ctx TransformContext, val interface{}) error {
-			if attrs, ok := val.(pcommon.Map); ok {
-				attrs.CopyTo(ctx.GetLogRecord().Attributes())
-			}
-			return nil
-		},
-	}
-}
-
-func accessAttributesKey(mapKey *string) ottl.StandardGetSetter[TransformContext] {
-	return ottl.StandardGetSetter[TransformContext]{
-		Getter: func(ctx TransformContext) (interface{}, error) {
-			return ottlcommon.GetMapValue(ctx.GetLogRecord().Attributes(), *mapKey), nil
-		},
-		Setter: func(ctx TransformContext, val interface{}) error {
-			ottlcommon.SetMapValue(ctx.GetLogRecord().Attributes(), *mapKey, val)
-			return nil
-		},
-	}
-}
-
 func accessDroppedAttributesCount() ottl.StandardGetSetter[TransformContext] {
 	return ottl.StandardGetSetter[TransformContext]{
 		Getter: func(ctx TransformContext) (interface{}, error) {
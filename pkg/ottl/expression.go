@@ -15,7 +15,10 @@
 package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 
 import (
+	"context"
 	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
 )
 
 type ExprFunc[K any] func(ctx K) (interface{}, error)
@@ -62,6 +65,55 @@ func (g exprGetter[K]) Get(ctx K) (interface{}, error) {
 	return g.expr(ctx)
 }
 
+// contextGetter is implemented by the getters that stop their evaluation once a context is done.
+type contextGetter[K any] interface {
+	getContext(ctx context.Context, tCtx K) (interface{}, error)
+}
+
+// getContext gets the value of getter, passing ctx along if the getter can use it.
+func getContext[K any](ctx context.Context, getter Getter[K], tCtx K) (interface{}, error) {
+	if g, ok := getter.(contextGetter[K]); ok {
+		return g.getContext(ctx, tCtx)
+	}
+	return getter.Get(tCtx)
+}
+
+// listGetter evaluates each of its values on every Get, returning them as a pcommon.Slice.
+type listGetter[K any] struct {
+	values []Getter[K]
+}
+
+func (l *listGetter[K]) Get(ctx K) (interface{}, error) {
+	return l.getContext(context.Background(), ctx)
+}
+
+// getContext evaluates the values like Get, but stops with ctx.Err() as soon as ctx is done.
+func (l *listGetter[K]) getContext(ctx context.Context, tCtx K) (interface{}, error) {
+	evaluated := pcommon.NewSlice()
+	evaluated.EnsureCapacity(len(l.values))
+	for _, v := range l.values {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		val, err := getContext(ctx, v, tCtx)
+		if err != nil {
+			return nil, err
+		}
+		elem := evaluated.AppendEmpty()
+		switch t := val.(type) {
+		case pcommon.Slice:
+			t.CopyTo(elem.SetEmptySlice())
+		case pcommon.Map:
+			t.CopyTo(elem.SetEmptyMap())
+		case pcommon.Value:
+			t.CopyTo(elem)
+		default:
+			elem.FromRaw(val)
+		}
+	}
+	return evaluated, nil
+}
+
 func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 	if val.IsNil != nil && *val.IsNil {
 		return &literal[K]{value: nil}, nil
@@ -95,6 +147,18 @@ func (p *Parser[K]) newGetter(val value) (Getter[K], error) {
 		return p.pathParser(val.Path)
 	}
 
+	if val.List != nil {
+		lg := listGetter[K]{}
+		for _, v := range val.List.Values {
+			getter, err := p.newGetter(v)
+			if err != nil {
+				return nil, err
+			}
+			lg.values = append(lg.values, getter)
+		}
+		return &lg, nil
+	}
+
 	if val.Invocation == nil {
 		// In practice, can't happen since the DSL grammar guarantees one is set
 		return nil, fmt.Errorf("no value field set. This is a bug in the OpenTelemetry Transformation Language")
@@ -18,7 +18,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -110,12 +112,13 @@ func Test_newGetter(t *testing.T) {
 
 	functions := map[string]interface{}{"hello": hello[interface{}]}
 
-	p := NewParser(
+	p, err := NewParser(
 		functions,
 		testParsePath,
 		testParseEnum,
 		component.TelemetrySettings{},
 	)
+	require.NoError(t, err)
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,3 +134,106 @@ func Test_newGetter(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_newGetter_list(t *testing.T) {
+	functions := map[string]interface{}{"hello": hello[interface{}]}
+	p, err := NewParser(
+		functions,
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	val := value{
+		List: &list{
+			Values: []value{
+				{
+					Path: &Path{
+						Fields: []Field{
+							{
+								Name: "name",
+							},
+						},
+					},
+				},
+				{
+					Int: ottltest.Intp(2),
+				},
+				{
+					List: &list{
+						Values: []value{
+							{
+								String: ottltest.Strp("a"),
+							},
+							{
+								Float: ottltest.Floatp(1.5),
+							},
+						},
+					},
+				},
+				{
+					Invocation: &invocation{
+						Function: "hello",
+					},
+				},
+				{
+					IsNil: (*isNil)(ottltest.Boolp(true)),
+				},
+			},
+		},
+	}
+
+	getter, err := p.newGetter(val)
+	require.NoError(t, err)
+
+	// the path is resolved on each Get
+	for _, name := range []string{"bear", "cat"} {
+		result, err := getter.Get(name)
+		require.NoError(t, err)
+		slice, ok := result.(pcommon.Slice)
+		require.True(t, ok)
+		assert.Equal(t, []interface{}{name, int64(2), []interface{}{"a", 1.5}, "world", nil}, slice.AsRaw())
+	}
+}
+
+func Test_newGetter_list_heterogeneous(t *testing.T) {
+	functions := map[string]interface{}{"hello": hello[interface{}]}
+	p, err := NewParser(
+		functions,
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	parsed, err := parseStatement(`set(name, [hello(), ["1", 2, 3.0], nil, name, 0x01])`)
+	require.NoError(t, err)
+
+	getter, err := p.newGetter(parsed.Invocation.Arguments[1])
+	require.NoError(t, err)
+	result, err := getter.Get("bear")
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"world", []interface{}{"1", int64(2), 3.0}, nil, "bear", []byte{1}}, result.(pcommon.Slice).AsRaw())
+}
+
+func Test_newGetter_list_invalid_element(t *testing.T) {
+	p, err := NewParser(
+		map[string]interface{}{},
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	_, err = p.newGetter(value{
+		List: &list{
+			Values: []value{
+				{
+					Enum: (*EnumSymbol)(ottltest.Strp("NOT_AN_ENUM")),
+				},
+			},
+		},
+	})
+	assert.Error(t, err)
+}
@@ -27,17 +27,81 @@ type EnumParser func(*EnumSymbol) (*Enum, error)
 
 type Enum int64
 
+// Optional is used to define a trailing parameter of a function that can be omitted when the function is invoked.
+// Optional parameters must come after all required parameters.
+type Optional[T any] struct {
+	val      T
+	hasValue bool
+}
+
+// IsEmpty returns true if the argument was omitted.
+func (o Optional[T]) IsEmpty() bool {
+	return !o.hasValue
+}
+
+// Get returns the value of the argument, or the zero value of T if it was omitted.
+func (o Optional[T]) Get() T {
+	return o.val
+}
+
+// NewTestingOptional returns an Optional holding val. It's intended to be used when testing
+// functions directly, without a Parser.
+func NewTestingOptional[T any](val T) Optional[T] {
+	return Optional[T]{
+		val:      val,
+		hasValue: true,
+	}
+}
+
+// optionalManager allows the Parser to build an Optional of any type without knowing T.
+type optionalManager interface {
+	// set returns an Optional[T] holding val.
+	set(val any) reflect.Value
+	// getWrappedType returns the type T of the Optional[T].
+	getWrappedType() reflect.Type
+}
+
+func (o Optional[T]) set(val any) reflect.Value {
+	return reflect.ValueOf(Optional[T]{
+		val:      val.(T),
+		hasValue: true,
+	})
+}
+
+func (o Optional[T]) getWrappedType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func optionalManagerFor(argType reflect.Type) (optionalManager, bool) {
+	if argType.Kind() != reflect.Struct {
+		return nil, false
+	}
+	manager, ok := reflect.Zero(argType).Interface().(optionalManager)
+	return manager, ok
+}
+
+func isOptionalArgType(argType reflect.Type) bool {
+	_, ok := optionalManagerFor(argType)
+	return ok
+}
+
 func (p *Parser[K]) newFunctionCall(inv invocation) (ExprFunc[K], error) {
 	f, ok := p.functions[inv.Function]
 	if !ok {
 		return nil, fmt.Errorf("undefined function %v", inv.Function)
 	}
-	args, err := p.buildArgs(inv, reflect.TypeOf(f))
+	fType := reflect.TypeOf(f)
+	args, err := p.buildArgs(inv, fType)
 	if err != nil {
 		return nil, err
 	}
 
-	returnVals := reflect.ValueOf(f).Call(args)
+	var returnVals []reflect.Value
+	if fType.IsVariadic() {
+		returnVals = reflect.ValueOf(f).CallSlice(args)
+	} else {
+		returnVals = reflect.ValueOf(f).Call(args)
+	}
 
 	if returnVals[1].IsNil() {
 		err = nil
@@ -49,6 +113,10 @@ func (p *Parser[K]) newFunctionCall(inv invocation) (ExprFunc[K], error) {
 }
 
 func (p *Parser[K]) buildArgs(inv invocation, fType reflect.Type) ([]reflect.Value, error) {
+	if err := p.checkArgCount(inv, fType); err != nil {
+		return nil, err
+	}
+
 	var args []reflect.Value
 	// Some function arguments may be intended to take values from the calling processor
 	// instead of being passed by the caller of the OTTL function, so we have to keep
@@ -58,8 +126,17 @@ func (p *Parser[K]) buildArgs(inv invocation, fType reflect.Type) ([]reflect.Val
 	for i := 0; i < fType.NumIn(); i++ {
 		argType := fType.In(i)
 
+		if fType.IsVariadic() && i == fType.NumIn()-1 {
+			arg, err := p.buildVariadicArg(inv, argType, DSLArgumentIndex)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			break
+		}
+
 		if argType.Kind() == reflect.Slice {
-			arg, err := p.buildSliceArg(inv, argType, i)
+			arg, err := p.buildSliceArg(inv, argType, DSLArgumentIndex)
 			if err != nil {
 				return nil, err
 			}
@@ -72,8 +149,19 @@ func (p *Parser[K]) buildArgs(inv invocation, fType reflect.Type) ([]reflect.Val
 				continue
 			}
 
-			if DSLArgumentIndex >= len(inv.Arguments) {
-				return nil, fmt.Errorf("not enough arguments for function %v", inv.Function)
+			if manager, isOptional := optionalManagerFor(argType); isOptional {
+				if DSLArgumentIndex >= len(inv.Arguments) {
+					args = append(args, reflect.Zero(argType))
+					DSLArgumentIndex++
+					continue
+				}
+				val, err := p.buildArg(inv.Arguments[DSLArgumentIndex], manager.getWrappedType(), DSLArgumentIndex)
+				if err != nil {
+					return nil, err
+				}
+				args = append(args, manager.set(val))
+				DSLArgumentIndex++
+				continue
 			}
 
 			argDef := inv.Arguments[DSLArgumentIndex]
@@ -89,11 +177,49 @@ func (p *Parser[K]) buildArgs(inv invocation, fType reflect.Type) ([]reflect.Val
 		DSLArgumentIndex++
 	}
 
-	if len(inv.Arguments) > DSLArgumentIndex {
-		return nil, fmt.Errorf("too many arguments for function %v", inv.Function)
+	return args, nil
+}
+
+// checkArgCount returns an error if the number of arguments passed within the DSL doesn't match
+// the parameters of the function, taking optional and variadic parameters into account.
+func (p *Parser[K]) checkArgCount(inv invocation, fType reflect.Type) error {
+	required, optional := 0, 0
+	for i := 0; i < fType.NumIn(); i++ {
+		argType := fType.In(i)
+		switch {
+		case fType.IsVariadic() && i == fType.NumIn()-1:
+			if len(inv.Arguments) < required {
+				return fmt.Errorf("function %v expects at least %v args, got %v", inv.Function, required, len(inv.Arguments))
+			}
+			return nil
+		case isInternalArgType(argType):
+		case isOptionalArgType(argType):
+			optional++
+		default:
+			required++
+		}
+	}
+	got := len(inv.Arguments)
+	if got >= required && got <= required+optional {
+		return nil
 	}
+	if optional == 0 {
+		return fmt.Errorf("function %v expects %v args, got %v", inv.Function, required, got)
+	}
+	return fmt.Errorf("function %v expects %v to %v args, got %v", inv.Function, required, required+optional, got)
+}
 
-	return args, nil
+// buildVariadicArg builds the slice for a variadic parameter from the remaining arguments passed within the DSL.
+func (p *Parser[K]) buildVariadicArg(inv invocation, argType reflect.Type, index int) (reflect.Value, error) {
+	vals := reflect.MakeSlice(argType, 0, len(inv.Arguments)-index)
+	for j := index; j < len(inv.Arguments); j++ {
+		val, err := p.buildArg(inv.Arguments[j], argType.Elem(), j)
+		if err != nil {
+			return reflect.ValueOf(nil), err
+		}
+		vals = reflect.Append(vals, reflect.ValueOf(val))
+	}
+	return vals, nil
 }
 
 func (p *Parser[K]) buildSliceArg(inv invocation, argType reflect.Type, index int) (reflect.Value, error) {
@@ -128,6 +254,12 @@ func (p *Parser[K]) buildSliceArg(inv invocation, argType reflect.Type, index in
 			return reflect.ValueOf(nil), err
 		}
 		return arg, nil
+	case strings.HasPrefix(name, "GetSetter"):
+		arg, err := buildSlice[GetSetter[K]](inv, argType, index, p.buildArg, name)
+		if err != nil {
+			return reflect.ValueOf(nil), err
+		}
+		return arg, nil
 	default:
 		return reflect.ValueOf(nil), fmt.Errorf("unsupported slice type '%s' for function '%v'", argType.Elem().Name(), inv.Function)
 	}
@@ -185,12 +317,21 @@ func (p *Parser[K]) buildArg(argDef value, argType reflect.Type, index int) (any
 // Handle interfaces that can be declared as parameters to a OTTL function, but will
 // never be called in an invocation. Returns whether the arg is an internal arg.
 func (p *Parser[K]) buildInternalArg(argType reflect.Type) (reflect.Value, bool) {
-	if argType.Name() == "TelemetrySettings" {
+	switch {
+	case argType.Name() == "TelemetrySettings":
 		return reflect.ValueOf(p.telemetrySettings), true
+	case argType == interner:
+		return reflect.ValueOf(p.interner), true
 	}
 	return reflect.ValueOf(nil), false
 }
 
+var interner = reflect.TypeOf((*Interner)(nil))
+
+func isInternalArgType(argType reflect.Type) bool {
+	return argType.Name() == "TelemetrySettings" || argType == interner
+}
+
 type buildArgFunc func(value, reflect.Type, int) (any, error)
 
 func buildSlice[T any](inv invocation, argType reflect.Type, index int, buildArg buildArgFunc, name string) (reflect.Value, error) {
@@ -217,3 +358,75 @@ func buildSlice[T any](inv invocation, argType reflect.Type, index int, buildArg
 
 	return reflect.ValueOf(vals), nil
 }
+
+// validateFunctions checks that every function can be invoked by the Parser: it must be a func
+// that returns (ExprFunc[K], error) and whose parameters are all types that buildArgs can provide.
+func validateFunctions[K any](functions map[string]interface{}) error {
+	exprFuncType := reflect.TypeOf((*ExprFunc[K])(nil)).Elem()
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	for name, f := range functions {
+		fType := reflect.TypeOf(f)
+		if fType == nil || fType.Kind() != reflect.Func {
+			return fmt.Errorf("function %v must be a func, got %T", name, f)
+		}
+		if fType.NumOut() != 2 || fType.Out(0) != exprFuncType || fType.Out(1) != errorType {
+			return fmt.Errorf("function %v must return (%v, error)", name, exprFuncType)
+		}
+		hasOptional := false
+		for i := 0; i < fType.NumIn(); i++ {
+			argType := fType.In(i)
+			switch {
+			case fType.IsVariadic() && i == fType.NumIn()-1:
+				if hasOptional {
+					return fmt.Errorf("function %v can't have both optional and variadic parameters", name)
+				}
+				if !isSupportedValueType(argType.Elem()) {
+					return fmt.Errorf("function %v has an unsupported parameter type %v at position %v", name, argType, i)
+				}
+			case isOptionalArgType(argType):
+				manager, _ := optionalManagerFor(argType)
+				if !isSupportedValueType(manager.getWrappedType()) {
+					return fmt.Errorf("function %v has an unsupported parameter type %v at position %v", name, argType, i)
+				}
+				hasOptional = true
+			case isInternalArgType(argType):
+			case !isSupportedArgType(argType):
+				return fmt.Errorf("function %v has an unsupported parameter type %v at position %v", name, argType, i)
+			case hasOptional:
+				return fmt.Errorf("function %v has a required parameter at position %v after an optional parameter", name, i)
+			}
+		}
+	}
+	return nil
+}
+
+func isSupportedArgType(argType reflect.Type) bool {
+	if argType.Kind() == reflect.Slice {
+		name := argType.Elem().Name()
+		switch {
+		case name == reflect.Uint8.String(), name == reflect.String.String(),
+			name == reflect.Float64.String(), name == reflect.Int64.String(),
+			strings.HasPrefix(name, "Getter"), strings.HasPrefix(name, "GetSetter"):
+			return true
+		default:
+			return false
+		}
+	}
+	return isInternalArgType(argType) || isSupportedValueType(argType)
+}
+
+// isSupportedValueType returns whether buildArg can build an argument of the given type.
+func isSupportedValueType(argType reflect.Type) bool {
+	name := argType.Name()
+	switch {
+	case strings.HasPrefix(name, "Setter"), strings.HasPrefix(name, "GetSetter"), strings.HasPrefix(name, "Getter"):
+		return true
+	case name == "Enum":
+		return true
+	case name == reflect.String.String(), name == reflect.Float64.String(),
+		name == reflect.Int64.String(), name == reflect.Bool.String():
+		return true
+	default:
+		return false
+	}
+}
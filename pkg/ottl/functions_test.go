@@ -16,9 +16,11 @@ package ottl
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/collector/component"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
@@ -33,15 +35,17 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 	functions["testing_string"] = functionWithString
 	functions["testing_string_slice"] = functionWithStringSlice
 	functions["testing_byte_slice"] = functionWithByteSlice
+	functions["testing_getsetter_slice"] = functionWithGetSetterSlice
 	functions["testing_enum"] = functionWithEnum
 	functions["testing_telemetry_settings_first"] = functionWithTelemetrySettingsFirst
 
-	p := NewParser(
+	p, err := NewParser(
 		functions,
 		testParsePath,
 		testParseEnum,
 		component.TelemetrySettings{},
 	)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name string
@@ -203,6 +207,23 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "literal in getsetter slice",
+			inv: invocation{
+				Function: "testing_getsetter_slice",
+				Arguments: []value{
+					{
+						List: &list{
+							Values: []value{
+								{
+									String: ottltest.Strp("test"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "mismatching slice argument type",
 			inv: invocation{
@@ -241,13 +262,96 @@ func Test_NewFunctionCall_invalid(t *testing.T) {
 	}
 }
 
+func Test_NewFunctionCall_argCount(t *testing.T) {
+	p, err := NewParser(
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		inv      invocation
+		expected string
+	}{
+		{
+			name: "not enough args",
+			inv: invocation{
+				Function: "testing_string",
+			},
+			expected: "function testing_string expects 1 args, got 0",
+		},
+		{
+			name: "too many args",
+			inv: invocation{
+				Function: "testing_string",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+					{
+						String: ottltest.Strp("test"),
+					},
+				},
+			},
+			expected: "function testing_string expects 1 args, got 2",
+		},
+		{
+			name: "internal args are not counted",
+			inv: invocation{
+				Function: "testing_telemetry_settings_middle",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+				},
+			},
+			expected: "function testing_telemetry_settings_middle expects 3 args, got 1",
+		},
+		{
+			name: "too many args with optional args",
+			inv: invocation{
+				Function: "testing_optional_args",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+					{
+						Int: ottltest.Intp(1),
+					},
+					{
+						Int: ottltest.Intp(1),
+					},
+				},
+			},
+			expected: "function testing_optional_args expects 1 to 2 args, got 3",
+		},
+		{
+			name: "not enough args with variadic args",
+			inv: invocation{
+				Function: "testing_variadic",
+			},
+			expected: "function testing_variadic expects at least 1 args, got 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.newFunctionCall(tt.inv)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
+
 func Test_NewFunctionCall(t *testing.T) {
-	p := NewParser(
+	p, err := NewParser(
 		defaultFunctionsForTests(),
 		testParsePath,
 		testParseEnum,
 		component.TelemetrySettings{},
 	)
+	require.NoError(t, err)
 
 	tests := []struct {
 		name string
@@ -394,6 +498,39 @@ func Test_NewFunctionCall(t *testing.T) {
 				},
 			},
 			want: 7,
+		},
+		{
+			name: "getsetter slice arg",
+			inv: invocation{
+				Function: "testing_getsetter_slice",
+				Arguments: []value{
+					{
+						List: &list{
+							Values: []value{
+								{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: 2,
 		}, {
 			name: "setter arg",
 			inv: invocation{
@@ -613,6 +750,89 @@ func Test_NewFunctionCall(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "optional arg omitted",
+			inv: invocation{
+				Function: "testing_optional_args",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("ab"),
+					},
+				},
+			},
+			want: "ab",
+		},
+		{
+			name: "optional arg provided",
+			inv: invocation{
+				Function: "testing_optional_args",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("ab"),
+					},
+					{
+						Int: ottltest.Intp(3),
+					},
+				},
+			},
+			want: "ababab",
+		},
+		{
+			name: "optional getter omitted",
+			inv: invocation{
+				Function:  "testing_optional_getter",
+				Arguments: []value{},
+			},
+			want: true,
+		},
+		{
+			name: "optional getter provided",
+			inv: invocation{
+				Function: "testing_optional_getter",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "variadic with no args",
+			inv: invocation{
+				Function: "testing_variadic",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+				},
+			},
+			want: 0,
+		},
+		{
+			name: "variadic with args",
+			inv: invocation{
+				Function: "testing_variadic",
+				Arguments: []value{
+					{
+						String: ottltest.Strp("test"),
+					},
+					{
+						Int: ottltest.Intp(1),
+					},
+					{
+						Path: &Path{
+							Fields: []Field{
+								{
+									Name: "name",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: 2,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -627,6 +847,123 @@ func Test_NewFunctionCall(t *testing.T) {
 	}
 }
 
+func Test_NewParser_functions(t *testing.T) {
+	tests := []struct {
+		name      string
+		functions map[string]interface{}
+		expected  string
+	}{
+		{
+			name:      "supported signatures",
+			functions: defaultFunctionsForTests(),
+		},
+		{
+			name:      "no functions",
+			functions: nil,
+		},
+		{
+			name: "unsupported parameter type",
+			functions: map[string]interface{}{
+				"testing_uint": functionWithUnsupportedArg,
+			},
+			expected: "function testing_uint has an unsupported parameter type uint32 at position 1",
+		},
+		{
+			name: "unsupported slice type",
+			functions: map[string]interface{}{
+				"testing_bool_slice": functionWithBoolSlice,
+			},
+			expected: "function testing_bool_slice has an unsupported parameter type []bool at position 0",
+		},
+		{
+			name: "unsupported optional type",
+			functions: map[string]interface{}{
+				"testing_optional_uint": functionWithUnsupportedOptional,
+			},
+			expected: "function testing_optional_uint has an unsupported parameter type ottl.Optional[uint32] at position 0",
+		},
+		{
+			name: "required after optional",
+			functions: map[string]interface{}{
+				"testing_required_after_optional": functionWithRequiredAfterOptional,
+			},
+			expected: "function testing_required_after_optional has a required parameter at position 1 after an optional parameter",
+		},
+		{
+			name: "optional and variadic",
+			functions: map[string]interface{}{
+				"testing_optional_variadic": functionWithOptionalAndVariadic,
+			},
+			expected: "function testing_optional_variadic can't have both optional and variadic parameters",
+		},
+		{
+			name: "not a func",
+			functions: map[string]interface{}{
+				"testing_string": "not a func",
+			},
+			expected: "function testing_string must be a func, got string",
+		},
+		{
+			name: "unsupported return type",
+			functions: map[string]interface{}{
+				"testing_return": functionWithoutExprFunc,
+			},
+			expected: "function testing_return must return (ottl.ExprFunc[interface {}], error)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser(tt.functions, testParsePath, testParseEnum, component.TelemetrySettings{})
+			if tt.expected == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_NewParser_customFunction(t *testing.T) {
+	p, err := NewParser(
+		map[string]interface{}{
+			"hello": functionHello,
+		},
+		testParsePath,
+		testParseEnum,
+		component.TelemetrySettings{},
+	)
+	require.NoError(t, err)
+
+	statements, err := p.ParseStatements([]string{`hello("world")`})
+	require.NoError(t, err)
+	result, condition, err := statements[0].Execute(nil)
+	assert.NoError(t, err)
+	assert.True(t, condition)
+	assert.Equal(t, "hello world", result)
+}
+
+func functionHello(name string) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return "hello " + name, nil
+	}, nil
+}
+
+func functionWithUnsupportedArg(string, uint32) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return nil, nil
+	}, nil
+}
+
+func functionWithBoolSlice([]bool) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return nil, nil
+	}, nil
+}
+
+func functionWithoutExprFunc(string) (string, error) {
+	return "", nil
+}
+
 func functionWithStringSlice(strs []string) (ExprFunc[interface{}], error) {
 	return func(interface{}) (interface{}, error) {
 		return len(strs), nil
@@ -657,6 +994,12 @@ func functionWithGetterSlice(getters []Getter[interface{}]) (ExprFunc[interface{
 	}, nil
 }
 
+func functionWithGetSetterSlice(getSetters []GetSetter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return len(getSetters), nil
+	}, nil
+}
+
 func functionWithSetter(Setter[interface{}]) (ExprFunc[interface{}], error) {
 	return func(interface{}) (interface{}, error) {
 		return "anything", nil
@@ -736,6 +1079,45 @@ func functionWithTelemetrySettingsLast(string, string, int64, component.Telemetr
 	}, nil
 }
 
+func functionWithOptionalArgs(s string, n Optional[int64]) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		if n.IsEmpty() {
+			return s, nil
+		}
+		return strings.Repeat(s, int(n.Get())), nil
+	}, nil
+}
+
+func functionWithOptionalGetter(getter Optional[Getter[interface{}]]) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return getter.IsEmpty(), nil
+	}, nil
+}
+
+func functionWithVariadicGetters(_ string, getters ...Getter[interface{}]) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return len(getters), nil
+	}, nil
+}
+
+func functionWithUnsupportedOptional(Optional[uint32]) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return nil, nil
+	}, nil
+}
+
+func functionWithRequiredAfterOptional(Optional[string], string) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return nil, nil
+	}, nil
+}
+
+func functionWithOptionalAndVariadic(Optional[string], ...string) (ExprFunc[interface{}], error) {
+	return func(interface{}) (interface{}, error) {
+		return nil, nil
+	}, nil
+}
+
 func defaultFunctionsForTests() map[string]interface{} {
 	functions := make(map[string]interface{})
 	functions["testing_string_slice"] = functionWithStringSlice
@@ -743,6 +1125,7 @@ func defaultFunctionsForTests() map[string]interface{} {
 	functions["testing_int_slice"] = functionWithIntSlice
 	functions["testing_byte_slice"] = functionWithByteSlice
 	functions["testing_getter_slice"] = functionWithGetterSlice
+	functions["testing_getsetter_slice"] = functionWithGetSetterSlice
 	functions["testing_setter"] = functionWithSetter
 	functions["testing_getsetter"] = functionWithGetSetter
 	functions["testing_getter"] = functionWithGetter
@@ -755,5 +1138,8 @@ func defaultFunctionsForTests() map[string]interface{} {
 	functions["testing_telemetry_settings_first"] = functionWithTelemetrySettingsFirst
 	functions["testing_telemetry_settings_middle"] = functionWithTelemetrySettingsMiddle
 	functions["testing_telemetry_settings_last"] = functionWithTelemetrySettingsLast
+	functions["testing_optional_args"] = functionWithOptionalArgs
+	functions["testing_optional_getter"] = functionWithOptionalGetter
+	functions["testing_variadic"] = functionWithVariadicGetters
 	return functions
 }
@@ -16,43 +16,93 @@ package ottl // import "github.com/open-telemetry/opentelemetry-collector-contri
 import (
 	"encoding/hex"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/alecthomas/participle/v2/lexer"
 )
 
 // parsedStatement represents a parsed statement. It is the entry point into the statement DSL.
+// A statement is either an invocation with an optional where clause, or a condition on its own.
 type parsedStatement struct {
-	Invocation  invocation         `parser:"@@"`
-	WhereClause *booleanExpression `parser:"( 'where' @@ )?"`
+	Invocation  *invocation        `parser:"( @@"`
+	WhereClause *booleanExpression `parser:"( 'where' @@ | EOF ) )"`
+	Condition   *booleanExpression `parser:"| @@"`
+}
+
+// String renders the parsedStatement as statement text that parses back into an equivalent parsedStatement.
+func (p parsedStatement) String() string {
+	switch {
+	case p.Condition != nil:
+		return p.Condition.String()
+	case p.WhereClause == nil:
+		return p.Invocation.String()
+	default:
+		return p.Invocation.String() + " where " + p.WhereClause.String()
+	}
 }
 
 // booleanValue represents something that evaluates to a boolean --
-// either an equality or inequality, explicit true or false, or
-// a parenthesized subexpression.
+// either an equality or inequality, an invocation of a function
+// that returns a boolean, explicit true or false, or a parenthesized
+// subexpression.
 type booleanValue struct {
 	Comparison *comparison        `parser:"( @@"`
+	Invocation *invocation        `parser:"| @@"`
 	ConstExpr  *boolean           `parser:"| @Boolean"`
 	SubExpr    *booleanExpression `parser:"| '(' @@ ')' )"`
 }
 
+func (b booleanValue) String() string {
+	switch {
+	case b.Comparison != nil:
+		return b.Comparison.String()
+	case b.Invocation != nil:
+		return b.Invocation.String()
+	case b.ConstExpr != nil:
+		return strconv.FormatBool(bool(*b.ConstExpr))
+	case b.SubExpr != nil:
+		return "(" + b.SubExpr.String() + ")"
+	default:
+		return ""
+	}
+}
+
 // opAndBooleanValue represents the right side of an AND boolean expression.
 type opAndBooleanValue struct {
 	Operator string        `parser:"@OpAnd"`
 	Value    *booleanValue `parser:"@@"`
 }
 
+func (o opAndBooleanValue) String() string {
+	return o.Operator + " " + o.Value.String()
+}
+
 // term represents an arbitrary number of boolean values joined by AND.
 type term struct {
 	Left  *booleanValue        `parser:"@@"`
 	Right []*opAndBooleanValue `parser:"@@*"`
 }
 
+func (t term) String() string {
+	parts := []string{t.Left.String()}
+	for _, r := range t.Right {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, " ")
+}
+
 // opOrTerm represents the right side of an OR boolean expression.
 type opOrTerm struct {
 	Operator string `parser:"@OpOr"`
 	Term     *term  `parser:"@@"`
 }
 
+func (o opOrTerm) String() string {
+	return o.Operator + " " + o.Term.String()
+}
+
 // booleanExpression represents a true/false decision expressed
 // as an arbitrary number of terms separated by OR.
 type booleanExpression struct {
@@ -60,6 +110,14 @@ type booleanExpression struct {
 	Right []*opOrTerm `parser:"@@*"`
 }
 
+func (b booleanExpression) String() string {
+	parts := []string{b.Left.String()}
+	for _, r := range b.Right {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, " ")
+}
+
 // compareOp is the type of a comparison operator.
 type compareOp int
 
@@ -71,16 +129,32 @@ const (
 	LTE
 	GTE
 	GT
+	CONTAINS
+	MATCHES
+	NOT_MATCHES
 )
 
 // a fast way to get from a string to a compareOp
 var compareOpTable = map[string]compareOp{
-	"==": EQ,
-	"!=": NE,
-	"<":  LT,
-	"<=": LTE,
-	">":  GT,
-	">=": GTE,
+	"==":       EQ,
+	"!=":       NE,
+	"<":        LT,
+	"<=":       LTE,
+	">":        GT,
+	">=":       GTE,
+	"contains": CONTAINS,
+	"matches":  MATCHES,
+	"!~":       NOT_MATCHES,
+}
+
+// symbol returns the operator string that is captured as this compareOp.
+func (c compareOp) symbol() string {
+	for symbol, op := range compareOpTable {
+		if op == c {
+			return symbol
+		}
+	}
+	return ""
 }
 
 // Capture is how the parser converts an operator string to a compareOp.
@@ -108,16 +182,46 @@ func (c *compareOp) String() string {
 		return "GTE"
 	case GT:
 		return "GT"
+	case CONTAINS:
+		return "CONTAINS"
+	case MATCHES:
+		return "MATCHES"
+	case NOT_MATCHES:
+		return "NOT_MATCHES"
 	default:
 		return "UNKNOWN OP!"
 	}
 }
 
 // comparison represents an optional boolean condition.
+// It either compares Left to Right using Op, or checks whether Left is within the Between range.
 type comparison struct {
-	Left  value     `parser:"@@"`
-	Op    compareOp `parser:"@OpComparison"`
-	Right value     `parser:"@@"`
+	Left    value     `parser:"@@"`
+	Op      compareOp `parser:"( @OpComparison"`
+	Right   value     `parser:"  @@"`
+	Between *between  `parser:"| @@ )"`
+}
+
+func (c comparison) String() string {
+	if c.Between != nil {
+		return c.Left.text() + " " + c.Between.String()
+	}
+	return c.Left.text() + " " + c.Op.symbol() + " " + c.Right.text()
+}
+
+// between represents an inclusive range, optionally negated, that the left side of a comparison is checked against.
+type between struct {
+	Not  bool  `parser:"@OpNot? OpBetween"`
+	Low  value `parser:"@@"`
+	High value `parser:"'and' @@"`
+}
+
+func (b between) String() string {
+	s := "between " + b.Low.text() + " and " + b.High.text()
+	if b.Not {
+		return "not " + s
+	}
+	return s
 }
 
 // invocation represents a function call.
@@ -126,6 +230,14 @@ type invocation struct {
 	Arguments []value `parser:"'(' ( @@ ( ',' @@ )* )? ')'"`
 }
 
+func (i invocation) String() string {
+	args := make([]string, len(i.Arguments))
+	for j, arg := range i.Arguments {
+		args[j] = arg.text()
+	}
+	return i.Function + "(" + strings.Join(args, ", ") + ")"
+}
+
 // value represents a part of a parsed statement which is resolved to a value of some sort. This can be a telemetry path
 // expression, function call, or literal.
 type value struct {
@@ -141,21 +253,98 @@ type value struct {
 	Path       *Path       `parser:"| @@ )"`
 }
 
+// text renders the value as statement text. value cannot implement fmt.Stringer because it has a field named String.
+func (v value) text() string {
+	switch {
+	case v.Invocation != nil:
+		return v.Invocation.String()
+	case v.Bytes != nil:
+		return "0x" + hex.EncodeToString(*v.Bytes)
+	case v.String != nil:
+		return strconv.Quote(*v.String)
+	case v.Float != nil:
+		f := strconv.FormatFloat(*v.Float, 'f', -1, 64)
+		// Integral floats must keep a decimal point so they are not lexed as Ints.
+		if !strings.Contains(f, ".") {
+			f += ".0"
+		}
+		return f
+	case v.Int != nil:
+		return strconv.FormatInt(*v.Int, 10)
+	case v.Bool != nil:
+		return strconv.FormatBool(bool(*v.Bool))
+	case v.IsNil != nil:
+		return "nil"
+	case v.Enum != nil:
+		return string(*v.Enum)
+	case v.List != nil:
+		return v.List.String()
+	case v.Path != nil:
+		return v.Path.String()
+	default:
+		return ""
+	}
+}
+
 // Path represents a telemetry path expression.
 type Path struct {
 	Fields []Field `parser:"@@ ( '.' @@ )*"`
 }
 
+func (p Path) String() string {
+	fields := make([]string, len(p.Fields))
+	for i, f := range p.Fields {
+		fields[i] = f.String()
+	}
+	return strings.Join(fields, ".")
+}
+
 // Field is an item within a Path.
+// Names that aren't lowercase identifiers, like names containing dots or spaces, can be written between backticks.
 type Field struct {
-	Name   string  `parser:"@Lowercase"`
+	Name   string  `parser:"( @Lowercase | @QuotedName )"`
 	MapKey *string `parser:"( '[' @String ']' )?"`
 }
 
+// unquotedFieldName matches the field names that can be written without backticks.
+var unquotedFieldName = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// reservedFieldNames are lowercase identifiers that the lexer or grammar treats as something other than a field name.
+var reservedFieldNames = map[string]bool{
+	"and":      true,
+	"or":       true,
+	"true":     true,
+	"false":    true,
+	"nil":      true,
+	"contains": true,
+	"matches":  true,
+	"not":      true,
+	"between":  true,
+}
+
+func (f Field) String() string {
+	name := f.Name
+	if !unquotedFieldName.MatchString(name) || reservedFieldNames[name] {
+		name = "`" + name + "`"
+	}
+	if f.MapKey == nil {
+		return name
+	}
+	return name + "[" + strconv.Quote(*f.MapKey) + "]"
+}
+
 type list struct {
 	Values []value `parser:"'[' (@@)* (',' @@)* ']'"`
 }
 
+func (l list) String() string {
+	values := make([]string, len(l.Values))
+	for i, v := range l.Values {
+		values[i] = v.text()
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
 // byteSlice type for capturing byte slices
 type byteSlice []byte
 
@@ -193,18 +382,24 @@ type EnumSymbol string
 func buildLexer() *lexer.StatefulDefinition {
 	return lexer.MustSimple([]lexer.SimpleRule{
 		{Name: `Bytes`, Pattern: `0x[a-fA-F0-9]+`},
-		{Name: `Float`, Pattern: `[-+]?\d*\.\d+([eE][-+]?\d+)?`},
+		{Name: `Float`, Pattern: `[-+]?(\d*\.\d+([eE][-+]?\d+)?|\d+[eE][-+]?\d+)`},
 		{Name: `Int`, Pattern: `[-+]?\d+`},
 		{Name: `String`, Pattern: `"(\\"|[^"])*"`},
+		{Name: `QuotedName`, Pattern: "`[^`]*`"},
 		{Name: `OpOr`, Pattern: `\b(or)\b`},
 		{Name: `OpAnd`, Pattern: `\b(and)\b`},
-		{Name: `OpComparison`, Pattern: `==|!=|>=|<=|>|<`},
+		{Name: `OpNot`, Pattern: `\b(not)\b`},
+		{Name: `OpBetween`, Pattern: `\b(between)\b`},
+		{Name: `OpComparison`, Pattern: `==|!=|!~|>=|<=|>|<|\b(contains|matches)\b`},
 		{Name: `Boolean`, Pattern: `\b(true|false)\b`},
 		{Name: `LParen`, Pattern: `\(`},
 		{Name: `RParen`, Pattern: `\)`},
 		{Name: `Punct`, Pattern: `[,.\[\]]`},
 		{Name: `Uppercase`, Pattern: `[A-Z_][A-Z0-9_]*`},
 		{Name: `Lowercase`, Pattern: `[a-z_][a-z0-9_]*`},
+		// Comments are elided like whitespace. As string literals are matched first, a # or /* inside
+		// a string doesn't start a comment.
+		{Name: "comment", Pattern: `#[^\n]*|/\*(?s:.*?)\*/`},
 		{Name: "whitespace", Pattern: `\s+`},
 	})
 }
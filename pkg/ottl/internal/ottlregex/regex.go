@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ottlregex compiles the regular expressions used by the OTTL.
+package ottlregex // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlregex"
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// Compile compiles pattern like regexp.Compile. Inline flags such as (?i) are supported. As Go uses
+// the RE2 syntax, Perl features like lookarounds and backreferences are not, and using them returns
+// an error that suggests an RE2-compatible rewrite.
+func Compile(pattern string) (*regexp.Regexp, error) {
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		if hint := unsupportedSyntaxHint(err); hint != "" {
+			return nil, fmt.Errorf("%w; %s", err, hint)
+		}
+		return nil, err
+	}
+	return compiled, nil
+}
+
+func unsupportedSyntaxHint(err error) string {
+	var syntaxErr *syntax.Error
+	if !errors.As(err, &syntaxErr) {
+		return ""
+	}
+	switch {
+	case isLookaround(syntaxErr.Expr):
+		return "lookarounds such as (?=...), (?!...), (?<=...) and (?<!...) are not supported by the RE2 syntax, " +
+			"rewrite the pattern to match the surrounding text instead, or use a separate negated match"
+	case syntaxErr.Code == syntax.ErrInvalidEscape && isBackreference(syntaxErr.Expr):
+		return "backreferences such as \\1 are not supported by the RE2 syntax, " +
+			"rewrite the pattern to spell out the repeated text instead"
+	default:
+		return ""
+	}
+}
+
+func isLookaround(expr string) bool {
+	for _, prefix := range []string{"(?=", "(?!", "(?<=", "(?<!"} {
+		if strings.HasPrefix(expr, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isBackreference(expr string) bool {
+	return len(expr) == 2 && expr[0] == '\\' && expr[1] >= '1' && expr[1] <= '9'
+}
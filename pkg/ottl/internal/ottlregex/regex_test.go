@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlregex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	compiled, err := Compile(`(?i)^hello`)
+	require.NoError(t, err)
+	assert.True(t, compiled.MatchString("HeLLo world"))
+	assert.False(t, compiled.MatchString("world hello"))
+}
+
+func TestCompile_error(t *testing.T) {
+	tests := []struct {
+		pattern     string
+		expectedErr string
+	}{
+		{
+			pattern: `foo(?=bar)`,
+			expectedErr: "error parsing regexp: invalid or unsupported Perl syntax: `(?=`; " +
+				"lookarounds such as (?=...), (?!...), (?<=...) and (?<!...) are not supported by the RE2 syntax, " +
+				"rewrite the pattern to match the surrounding text instead, or use a separate negated match",
+		},
+		{
+			pattern: `foo(?!bar)`,
+			expectedErr: "error parsing regexp: invalid or unsupported Perl syntax: `(?!`; " +
+				"lookarounds such as (?=...), (?!...), (?<=...) and (?<!...) are not supported by the RE2 syntax, " +
+				"rewrite the pattern to match the surrounding text instead, or use a separate negated match",
+		},
+		{
+			pattern: `(?<!foo)bar`,
+			expectedErr: "error parsing regexp: invalid named capture: `(?<!foo)bar`; " +
+				"lookarounds such as (?=...), (?!...), (?<=...) and (?<!...) are not supported by the RE2 syntax, " +
+				"rewrite the pattern to match the surrounding text instead, or use a separate negated match",
+		},
+		{
+			pattern: `(a)\1`,
+			expectedErr: "error parsing regexp: invalid escape sequence: `\\1`; " +
+				"backreferences such as \\1 are not supported by the RE2 syntax, " +
+				"rewrite the pattern to spell out the repeated text instead",
+		},
+		{
+			pattern:     `*`,
+			expectedErr: "error parsing regexp: missing argument to repetition operator: `*`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			compiled, err := Compile(tt.pattern)
+			assert.EqualError(t, err, tt.expectedErr)
+			assert.Nil(t, compiled)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"sync"
+)
+
+// Interner deduplicates strings, such as the keys of the maps built by functions, so that identical strings
+// produced while processing many records share the same memory. It is safe for concurrent use.
+// Functions can receive the Parser's Interner by declaring a *Interner parameter, which is provided
+// by the Parser like TelemetrySettings. It is nil unless the Parser was created with WithStringInterning.
+type Interner struct {
+	mu         sync.RWMutex
+	strings    map[string]string
+	maxStrings int
+}
+
+// NewInterner returns an Interner that keeps at most maxStrings distinct strings.
+// Once it is full, strings that haven't been interned yet are returned unchanged.
+func NewInterner(maxStrings int) *Interner {
+	return &Interner{
+		strings:    make(map[string]string),
+		maxStrings: maxStrings,
+	}
+}
+
+// Intern returns a string equal to s, which is shared by all the calls with an equal string.
+// Calling Intern on a nil Interner returns s, so functions don't need to check whether interning is enabled.
+func (i *Interner) Intern(s string) string {
+	if i == nil {
+		return s
+	}
+	i.mu.RLock()
+	interned, ok := i.strings[s]
+	i.mu.RUnlock()
+	if ok {
+		return interned
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if interned, ok = i.strings[s]; ok {
+		return interned
+	}
+	if len(i.strings) >= i.maxStrings {
+		return s
+	}
+	// s is copied so that the Interner doesn't keep alive a larger string s may have been sliced from.
+	interned = string([]byte(s))
+	i.strings[interned] = interned
+	return interned
+}
+
+// Option configures a Parser.
+type Option[K any] func(*Parser[K])
+
+// WithStringInterning makes the Parser provide an Interner that keeps at most maxStrings distinct strings
+// to the functions that declare a *Interner parameter. Interned strings are retained for as long as the
+// parsed statements are, which is why interning is disabled unless this option is used.
+func WithStringInterning[K any](maxStrings int) Option[K] {
+	return func(p *Parser[K]) {
+		p.interner = NewInterner(maxStrings)
+	}
+}
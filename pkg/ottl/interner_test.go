@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+// stringData returns the address of the bytes backing s, to tell whether two strings share memory.
+func stringData(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data
+}
+
+func Test_Interner(t *testing.T) {
+	interner := NewInterner(10)
+
+	first := interner.Intern(strings.Repeat("a", 3))
+	second := interner.Intern(strings.Repeat("a", 3))
+	assert.Equal(t, "aaa", first)
+	assert.Equal(t, first, second)
+	assert.Equal(t, stringData(first), stringData(second))
+
+	other := interner.Intern("b")
+	assert.Equal(t, "b", other)
+	assert.NotEqual(t, stringData(first), stringData(other))
+}
+
+func Test_Interner_copies_substrings(t *testing.T) {
+	interner := NewInterner(10)
+	source := "http.method=GET"
+	interned := interner.Intern(source[:len("http.method")])
+	assert.Equal(t, "http.method", interned)
+	assert.NotEqual(t, stringData(source), stringData(interned))
+}
+
+func Test_Interner_full(t *testing.T) {
+	interner := NewInterner(1)
+	interner.Intern("a")
+
+	s := strings.Repeat("b", 2)
+	assert.Equal(t, stringData(s), stringData(interner.Intern(s)))
+	assert.Equal(t, "a", interner.Intern("a"))
+}
+
+func Test_Interner_nil(t *testing.T) {
+	var interner *Interner
+	s := strings.Repeat("a", 3)
+	assert.Equal(t, stringData(s), stringData(interner.Intern(s)))
+}
+
+func Test_WithStringInterning(t *testing.T) {
+	var interners []*Interner
+	functions := map[string]interface{}{
+		"testing_interner": func(interner *Interner, target Getter[interface{}]) (ExprFunc[interface{}], error) {
+			interners = append(interners, interner)
+			return func(interface{}) (interface{}, error) {
+				return nil, nil
+			}, nil
+		},
+	}
+
+	p, err := NewParser[interface{}](functions, testParsePath, testParseEnum, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	_, err = p.ParseStatements([]string{`testing_interner(name)`})
+	require.NoError(t, err)
+	require.Len(t, interners, 1)
+	assert.Nil(t, interners[0])
+
+	p, err = NewParser[interface{}](functions, testParsePath, testParseEnum, componenttest.NewNopTelemetrySettings(), WithStringInterning[interface{}](10))
+	require.NoError(t, err)
+	_, err = p.ParseStatements([]string{`testing_interner(name)`, `testing_interner(name)`})
+	require.NoError(t, err)
+	require.Len(t, interners, 3)
+	assert.NotNil(t, interners[1])
+	// statements parsed by the same Parser share its Interner
+	assert.Same(t, interners[1], interners[2])
+}
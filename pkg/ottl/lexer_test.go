@@ -57,6 +57,14 @@ func Test_lexer(t *testing.T) {
 			{"OpComparison", "!="},
 			{"Float", "4.9"},
 		}},
+		{"scientific_notation", "1e6 < 2.5e-3", false, []result{
+			{"Float", "1e6"},
+			{"OpComparison", "<"},
+			{"Float", "2.5e-3"},
+		}},
+		{"hex_bytes_not_exponent", "0x1e6", false, []result{
+			{"Bytes", "0x1e6"},
+		}},
 		{"unambiguous_names", "foo bar BAZZ", false, []result{
 			{"Lowercase", "foo"},
 			{"Lowercase", "bar"},
@@ -83,6 +91,48 @@ func Test_lexer(t *testing.T) {
 			{"OpOr", "or"},
 			{"Lowercase", "but"},
 		}},
+		{"parse_contains", `name contains "x"`, false, []result{
+			{"Lowercase", "name"},
+			{"OpComparison", "contains"},
+			{"String", `"x"`},
+		}},
+		{"parse_not_matches", `name !~ "^x"`, false, []result{
+			{"Lowercase", "name"},
+			{"OpComparison", "!~"},
+			{"String", `"^x"`},
+		}},
+		{"name_containing_matches", "matchesfoo matches", false, []result{
+			{"Lowercase", "matchesfoo"}, // should not parse "matches" as an operator
+			{"OpComparison", "matches"},
+		}},
+		{"quoted_name", "`weird.field`.sub", false, []result{
+			{"QuotedName", "`weird.field`"},
+			{"Punct", "."},
+			{"Lowercase", "sub"},
+		}},
+		{"parse_not_between", "x not between 1 and 2", false, []result{
+			{"Lowercase", "x"},
+			{"OpNot", "not"},
+			{"OpBetween", "between"},
+			{"Int", "1"},
+			{"OpAnd", "and"},
+			{"Int", "2"},
+		}},
+		{"name_containing_not", "nothing notbetween", false, []result{
+			{"Lowercase", "nothing"},
+			{"Lowercase", "notbetween"},
+		}},
+		{"line_comment", "foo # bar \"baz\"\nqux", false, []result{
+			{"Lowercase", "foo"},
+			{"Lowercase", "qux"},
+		}},
+		{"block_comment", "foo /* bar\n baz */ qux", false, []result{
+			{"Lowercase", "foo"},
+			{"Lowercase", "qux"},
+		}},
+		{"comment_in_string", `"# foo /* bar */"`, false, []result{
+			{"String", `"# foo /* bar */"`},
+		}},
 		{"nothing_recognizable", "{}", true, []result{
 			{"", ""},
 		}},
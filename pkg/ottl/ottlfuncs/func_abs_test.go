@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Abs(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "negative int",
+			value:    int64(-42),
+			expected: int64(42),
+		},
+		{
+			name:     "positive int",
+			value:    int64(42),
+			expected: int64(42),
+		},
+		{
+			name:     "max int",
+			value:    int64(math.MaxInt64),
+			expected: int64(math.MaxInt64),
+		},
+		{
+			name:     "negative float",
+			value:    -1.5,
+			expected: 1.5,
+		},
+		{
+			name:     "positive float",
+			value:    1.5,
+			expected: 1.5,
+		},
+		{
+			name:     "zero",
+			value:    int64(0),
+			expected: int64(0),
+		},
+		{
+			name:     "string target",
+			value:    "-1",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Abs[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Abs_overflow(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return int64(math.MinInt64), nil
+		},
+	}
+	exprFunc, err := Abs[interface{}](target)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.EqualError(t, err, "the absolute value of -9223372036854775808 overflows an int64")
+	assert.Nil(t, result)
+}
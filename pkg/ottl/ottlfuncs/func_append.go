@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Append[K any](target ottl.GetSetter[K], value ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var slice pcommon.Slice
+		switch v := val.(type) {
+		case nil:
+			slice = pcommon.NewSlice()
+		case pcommon.Slice:
+			slice = v
+		default:
+			return nil, fmt.Errorf("cannot append to a %T, the target must be a slice", val)
+		}
+
+		newVal, err := value.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// No fields currently support `null` as a valid type.
+		if newVal == nil {
+			return nil, nil
+		}
+		elem := pcommon.NewValueEmpty()
+		if err = ottl.SetValue(elem, newVal); err != nil {
+			return nil, err
+		}
+		elem.CopyTo(slice.AppendEmpty())
+
+		return nil, target.Set(ctx, slice)
+	}, nil
+}
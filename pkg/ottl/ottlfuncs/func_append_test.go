@@ -0,0 +1,164 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+)
+
+func Test_Append(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			val, ok := ctx.Get("list")
+			if !ok {
+				return nil, nil
+			}
+			if val.Type() == pcommon.ValueTypeSlice {
+				return val.Slice(), nil
+			}
+			return val.Str(), nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			val.(pcommon.Slice).CopyTo(ctx.PutEmptySlice("list"))
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name  string
+		input func(pcommon.Map)
+		value interface{}
+		want  func(pcommon.Map)
+	}{
+		{
+			name:  "missing slice",
+			input: func(input pcommon.Map) {},
+			value: "a",
+			want: func(expected pcommon.Map) {
+				expected.PutEmptySlice("list").AppendEmpty().SetStr("a")
+			},
+		},
+		{
+			name: "empty slice",
+			input: func(input pcommon.Map) {
+				input.PutEmptySlice("list")
+			},
+			value: int64(1),
+			want: func(expected pcommon.Map) {
+				expected.PutEmptySlice("list").AppendEmpty().SetInt(1)
+			},
+		},
+		{
+			name: "existing slice",
+			input: func(input pcommon.Map) {
+				list := input.PutEmptySlice("list")
+				list.AppendEmpty().SetStr("a")
+				list.AppendEmpty().SetBool(true)
+			},
+			value: 1.5,
+			want: func(expected pcommon.Map) {
+				list := expected.PutEmptySlice("list")
+				list.AppendEmpty().SetStr("a")
+				list.AppendEmpty().SetBool(true)
+				list.AppendEmpty().SetDouble(1.5)
+			},
+		},
+		{
+			name: "append nil",
+			input: func(input pcommon.Map) {
+				input.PutEmptySlice("list").AppendEmpty().SetStr("a")
+			},
+			value: nil,
+			want: func(expected pcommon.Map) {
+				expected.PutEmptySlice("list").AppendEmpty().SetStr("a")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			tt.input(scenarioMap)
+
+			exprFunc, err := Append[pcommon.Map](target, &ottl.StandardGetSetter[pcommon.Map]{
+				Getter: func(pcommon.Map) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+
+			_, err = exprFunc(scenarioMap)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+
+			assert.Equal(t, expected, scenarioMap)
+		})
+	}
+}
+
+func Test_Append_bad_input(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "not a slice", nil
+		},
+		Setter: func(ctx interface{}, val interface{}) error {
+			t.Errorf("nothing should be set in this scenario")
+			return nil
+		},
+	}
+
+	exprFunc, err := Append[interface{}](target, &ottl.StandardGetSetter[interface{}]{
+		Getter: func(interface{}) (interface{}, error) {
+			return "a", nil
+		},
+	})
+	assert.NoError(t, err)
+
+	_, err = exprFunc(nil)
+	assert.EqualError(t, err, "cannot append to a string, the target must be a slice")
+}
+
+func Test_Append_attributes(t *testing.T) {
+	parser, err := ottllogs.NewParser(map[string]interface{}{
+		"append": Append[ottllogs.TransformContext],
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{
+		`append(attributes["tags"], "a")`,
+		`append(attributes["tags"], 2)`,
+	})
+	require.NoError(t, err)
+
+	logRecord := plog.NewLogRecord()
+	ctx := ottllogs.NewTransformContext(logRecord, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+	for _, statement := range statements {
+		_, _, err = statement.Execute(ctx)
+		require.NoError(t, err)
+	}
+
+	tags, ok := logRecord.Attributes().Get("tags")
+	require.True(t, ok)
+	assert.Equal(t, []interface{}{"a", int64(2)}, tags.Slice().AsRaw())
+}
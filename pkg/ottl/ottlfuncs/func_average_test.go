@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Average(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "int slice",
+			value:    sliceOf(int64(1), int64(2)),
+			expected: 1.5,
+		},
+		{
+			name:     "float slice",
+			value:    sliceOf(0.5, 2.5, -1.5),
+			expected: 0.5,
+		},
+		{
+			name:     "mixed ints and floats",
+			value:    sliceOf(int64(1), 2.0),
+			expected: 1.5,
+		},
+		{
+			name:     "empty slice",
+			value:    pcommon.NewSlice(),
+			expected: nil,
+		},
+		{
+			name:     "non-slice target",
+			value:    int64(1),
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Average[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Average_bad_input(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return sliceOf(1.0, "2"), nil
+		},
+	}
+	exprFunc, err := Average[interface{}](target)
+	require.NoError(t, err)
+	_, err = exprFunc(nil)
+	assert.EqualError(t, err, "the slice supplied to Average must only contain numbers, got Str at index 1")
+}
@@ -4,7 +4,7 @@
 // you may not use this file except in compliance with the License.
 // You may obtain a copy of the License at
 //
-//       http://www.apache.org/licenses/LICENSE-2.0
+//      http://www.apache.org/licenses/LICENSE-2.0
 //
 // Unless required by applicable law or agreed to in writing, software
 // distributed under the License is distributed on an "AS IS" BASIS,
@@ -12,20 +12,21 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
+package ottlfuncs
+
 import (
-	"context"
+	"testing"
 
-	"go.opentelemetry.io/collector/component"
-)
+	"github.com/stretchr/testify/assert"
 
-type oracledbreceiver struct {
-}
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
 
-func (o oracledbreceiver) Start(_ context.Context, host component.Host) error {
-	return nil
-}
+func Test_break(t *testing.T) {
+	exprFunc, err := Break[interface{}]()
+	assert.NoError(t, err)
 
-func (o oracledbreceiver) Shutdown(_ context.Context) error {
-	return nil
+	result, err := exprFunc(nil)
+	assert.ErrorIs(t, err, ottl.ErrBreak)
+	assert.Nil(t, result)
 }
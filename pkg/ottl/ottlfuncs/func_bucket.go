@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Bucket[K any](target ottl.Getter[K], boundaries []float64) (ottl.ExprFunc[K], error) {
+	if len(boundaries) == 0 {
+		return nil, errors.New("at least one boundary must be supplied to Bucket")
+	}
+	for i := 1; i < len(boundaries); i++ {
+		if boundaries[i] <= boundaries[i-1] {
+			return nil, fmt.Errorf("the boundaries supplied to Bucket must be in increasing order, got %v", boundaries)
+		}
+	}
+
+	// the labels are computed once, labels[i] is the label of the values lower than boundaries[i]
+	labels := make([]string, len(boundaries)+1)
+	labels[0] = "<" + formatBoundary(boundaries[0])
+	for i := 1; i < len(boundaries); i++ {
+		labels[i] = formatBoundary(boundaries[i-1]) + "-" + formatBoundary(boundaries[i])
+	}
+	labels[len(boundaries)] = ">=" + formatBoundary(boundaries[len(boundaries)-1])
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var v float64
+		switch n := val.(type) {
+		case int64:
+			v = float64(n)
+		case float64:
+			v = n
+		default:
+			return nil, nil
+		}
+		// the index of the first boundary greater than the value
+		i := sort.Search(len(boundaries), func(i int) bool {
+			return boundaries[i] > v
+		})
+		return labels[i], nil
+	}, nil
+}
+
+func formatBoundary(b float64) string {
+	return strconv.FormatFloat(b, 'f', -1, 64)
+}
@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func BucketIndex[K any](target ottl.Getter[K], bounds []float64) (ottl.ExprFunc[K], error) {
+	for i := 1; i < len(bounds); i++ {
+		if bounds[i] <= bounds[i-1] {
+			return nil, fmt.Errorf("the bounds supplied to BucketIndex must be in increasing order, got %v", bounds)
+		}
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var v float64
+		switch n := val.(type) {
+		case int64:
+			v = float64(n)
+		case float64:
+			v = n
+		default:
+			return nil, nil
+		}
+		// Like the explicit bounds of OTLP histograms, the upper bound of a bucket is inclusive, so
+		// this is the index of the first bound greater than or equal to the value.
+		return int64(sort.SearchFloat64s(bounds, v)), nil
+	}, nil
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_BucketIndex(t *testing.T) {
+	bounds := []float64{100, 500, 1000}
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "below the first bound",
+			value:    int64(42),
+			expected: int64(0),
+		},
+		{
+			name:     "negative",
+			value:    -1.5,
+			expected: int64(0),
+		},
+		{
+			name:     "first bound",
+			value:    int64(100),
+			expected: int64(0),
+		},
+		{
+			name:     "between bounds",
+			value:    100.1,
+			expected: int64(1),
+		},
+		{
+			name:     "middle bound",
+			value:    int64(500),
+			expected: int64(1),
+		},
+		{
+			name:     "before the last bound",
+			value:    999.9,
+			expected: int64(2),
+		},
+		{
+			name:     "last bound",
+			value:    int64(1000),
+			expected: int64(2),
+		},
+		{
+			name:     "above the last bound",
+			value:    12000.0,
+			expected: int64(3),
+		},
+		{
+			name:     "string target",
+			value:    "200",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := BucketIndex[interface{}](target, bounds)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_BucketIndex_noBounds(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return 0.25, nil
+		},
+	}
+	exprFunc, err := BucketIndex[interface{}](target, []float64{})
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), result)
+}
+
+func Test_BucketIndex_unsortedBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		bounds   []float64
+		expected string
+	}{
+		{
+			name:     "unsorted",
+			bounds:   []float64{500, 100, 1000},
+			expected: "the bounds supplied to BucketIndex must be in increasing order, got [500 100 1000]",
+		},
+		{
+			name:     "duplicate",
+			bounds:   []float64{100, 100},
+			expected: "the bounds supplied to BucketIndex must be in increasing order, got [100 100]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{}
+			exprFunc, err := BucketIndex[interface{}](target, tt.bounds)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Bucket(t *testing.T) {
+	boundaries := []float64{100, 500, 1000}
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "below the first boundary",
+			value:    int64(42),
+			expected: "<100",
+		},
+		{
+			name:     "negative",
+			value:    -1.5,
+			expected: "<100",
+		},
+		{
+			name:     "first boundary",
+			value:    int64(100),
+			expected: "100-500",
+		},
+		{
+			name:     "between boundaries",
+			value:    499.9,
+			expected: "100-500",
+		},
+		{
+			name:     "middle boundary",
+			value:    int64(500),
+			expected: "500-1000",
+		},
+		{
+			name:     "last boundary",
+			value:    int64(1000),
+			expected: ">=1000",
+		},
+		{
+			name:     "above the last boundary",
+			value:    12000.0,
+			expected: ">=1000",
+		},
+		{
+			name:     "string target",
+			value:    "200",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Bucket[interface{}](target, boundaries)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Bucket_singleBoundary(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return 0.25, nil
+		},
+	}
+	exprFunc, err := Bucket[interface{}](target, []float64{0.5})
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "<0.5", result)
+}
+
+func Test_Bucket_invalidBoundaries(t *testing.T) {
+	tests := []struct {
+		name       string
+		boundaries []float64
+		expected   string
+	}{
+		{
+			name:       "unsorted",
+			boundaries: []float64{500, 100, 1000},
+			expected:   "the boundaries supplied to Bucket must be in increasing order, got [500 100 1000]",
+		},
+		{
+			name:       "duplicate",
+			boundaries: []float64{100, 100},
+			expected:   "the boundaries supplied to Bucket must be in increasing order, got [100 100]",
+		},
+		{
+			name:       "empty",
+			boundaries: []float64{},
+			expected:   "at least one boundary must be supplied to Bucket",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{}
+			exprFunc, err := Bucket[interface{}](target, tt.boundaries)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
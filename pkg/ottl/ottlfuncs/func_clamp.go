@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Clamp[K any](target ottl.Getter[K], lo float64, hi float64) (ottl.ExprFunc[K], error) {
+	if lo > hi {
+		return nil, fmt.Errorf("the low bound supplied to Clamp can't be greater than the high bound, got %v and %v", lo, hi)
+	}
+	// int64 targets are bounded by the integers closest to the inside of the range, if there are any
+	intLo, intHi := math.Ceil(lo), math.Floor(hi)
+	hasInts := intLo <= intHi
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case int64:
+			switch f := float64(v); {
+			case f < lo && hasInts:
+				return int64(intLo), nil
+			case f < lo:
+				return lo, nil
+			case f > hi && hasInts:
+				return int64(intHi), nil
+			case f > hi:
+				return hi, nil
+			default:
+				return v, nil
+			}
+		case float64:
+			switch {
+			case v < lo:
+				return lo, nil
+			case v > hi:
+				return hi, nil
+			default:
+				return v, nil
+			}
+		default:
+			return nil, nil
+		}
+	}, nil
+}
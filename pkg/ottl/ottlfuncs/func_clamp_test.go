@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Clamp(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		lo       float64
+		hi       float64
+		expected interface{}
+	}{
+		{
+			name:     "below range",
+			value:    -5.5,
+			lo:       0,
+			hi:       100,
+			expected: 0.0,
+		},
+		{
+			name:     "int below range",
+			value:    int64(-5),
+			lo:       0,
+			hi:       100,
+			expected: int64(0),
+		},
+		{
+			name:     "int above range",
+			value:    int64(150),
+			lo:       0,
+			hi:       100,
+			expected: int64(100),
+		},
+		{
+			name:     "int below a fractional bound",
+			value:    int64(-5),
+			lo:       -2.5,
+			hi:       2.5,
+			expected: int64(-2),
+		},
+		{
+			name:     "int above a fractional bound",
+			value:    int64(5),
+			lo:       -2.5,
+			hi:       2.5,
+			expected: int64(2),
+		},
+		{
+			name:     "int below a range without integers",
+			value:    int64(-5),
+			lo:       0.2,
+			hi:       0.8,
+			expected: 0.2,
+		},
+		{
+			name:     "int above a range without integers",
+			value:    int64(5),
+			lo:       0.2,
+			hi:       0.8,
+			expected: 0.8,
+		},
+		{
+			name:     "in range",
+			value:    42.5,
+			lo:       0,
+			hi:       100,
+			expected: 42.5,
+		},
+		{
+			name:     "int in range keeps its type",
+			value:    int64(42),
+			lo:       0,
+			hi:       100,
+			expected: int64(42),
+		},
+		{
+			name:     "bound",
+			value:    int64(100),
+			lo:       0,
+			hi:       100,
+			expected: int64(100),
+		},
+		{
+			name:     "above range",
+			value:    250.5,
+			lo:       0,
+			hi:       100,
+			expected: 100.0,
+		},
+		{
+			name:     "equal bounds",
+			value:    7.0,
+			lo:       1,
+			hi:       1,
+			expected: 1.0,
+		},
+		{
+			name:     "string target",
+			value:    "42",
+			lo:       0,
+			hi:       100,
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			lo:       0,
+			hi:       100,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Clamp[interface{}](target, tt.lo, tt.hi)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Clamp_invalidBounds(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{}
+	exprFunc, err := Clamp[interface{}](target, 100, 0)
+	assert.EqualError(t, err, "the low bound supplied to Clamp can't be greater than the high bound, got 100 and 0")
+	assert.Nil(t, exprFunc)
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Count(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		substr   string
+		expected interface{}
+	}{
+		{
+			name:     "multiple occurrences",
+			value:    "/api/v1/users/1",
+			substr:   "/",
+			expected: int64(4),
+		},
+		{
+			name:     "non-overlapping occurrences",
+			value:    "aaaa",
+			substr:   "aa",
+			expected: int64(2),
+		},
+		{
+			name:     "zero occurrences",
+			value:    "/api/v1/users/1",
+			substr:   "?",
+			expected: int64(0),
+		},
+		{
+			name:     "non-string target",
+			value:    int64(11),
+			substr:   "1",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			substr:   "/",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Count[interface{}](target, tt.substr)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Count_empty_substr(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "anything", nil
+		},
+	}
+	exprFunc, err := Count[interface{}](target, "")
+	assert.EqualError(t, err, "the substring supplied to Count can't be empty")
+	assert.Nil(t, exprFunc)
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"golang.org/x/text/encoding/ianaindex"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func DecodeCharset[K any](target ottl.Getter[K], charset string) (ottl.ExprFunc[K], error) {
+	encoding, err := ianaindex.IANA.Encoding(charset)
+	if err != nil {
+		return nil, fmt.Errorf("the charset supplied to DecodeCharset is unknown, got %q: %w", charset, err)
+	}
+	// some charsets are known but not supported
+	if encoding == nil {
+		return nil, fmt.Errorf("the charset supplied to DecodeCharset isn't supported, got %q", charset)
+	}
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var b []byte
+		switch v := val.(type) {
+		case []byte:
+			b = v
+		case string:
+			b = []byte(v)
+		default:
+			return nil, nil
+		}
+		decoded, err := encoding.NewDecoder().Bytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode %s string: %w", charset, err)
+		}
+		return string(decoded), nil
+	}, nil
+}
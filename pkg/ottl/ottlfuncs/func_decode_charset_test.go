@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_DecodeCharset(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		charset  string
+		expected interface{}
+	}{
+		{
+			name:     "latin1 bytes",
+			value:    []byte{'c', 'a', 'f', 0xe9},
+			charset:  "latin1",
+			expected: "café",
+		},
+		{
+			name:     "latin1 string",
+			value:    "Z\xfcrich",
+			charset:  "ISO-8859-1",
+			expected: "Zürich",
+		},
+		{
+			name:     "utf-16 with little endian BOM",
+			value:    []byte{0xff, 0xfe, 'h', 0x00, 'i', 0x00},
+			charset:  "utf-16",
+			expected: "hi",
+		},
+		{
+			name:     "utf-16 without BOM",
+			value:    []byte{0x00, 'h', 0x00, 'i'},
+			charset:  "utf-16",
+			expected: "hi",
+		},
+		{
+			name:     "utf-16le",
+			value:    []byte{0xac, 0x20},
+			charset:  "UTF-16LE",
+			expected: "€",
+		},
+		{
+			name:     "invalid bytes are replaced",
+			value:    []byte{0x82, 0xa0, 0xff},
+			charset:  "Shift_JIS",
+			expected: "あ\ufffd",
+		},
+		{
+			name:     "int target",
+			value:    int64(1),
+			charset:  "latin1",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			charset:  "latin1",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := DecodeCharset[interface{}](target, tt.charset)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_DecodeCharset_unknownCharset(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{}
+	exprFunc, err := DecodeCharset[interface{}](target, "klingon")
+	assert.ErrorContains(t, err, `the charset supplied to DecodeCharset is unknown, got "klingon"`)
+	assert.Nil(t, exprFunc)
+}
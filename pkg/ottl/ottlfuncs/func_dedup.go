@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Dedup[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := val.(pcommon.Slice)
+		if !ok {
+			return nil, nil
+		}
+
+		// The duplicates are found before removing them because RemoveIf moves the elements it keeps.
+		duplicates := make([]bool, slice.Len())
+		for i := 0; i < slice.Len(); i++ {
+			for j := 0; j < i; j++ {
+				if !duplicates[j] && dedupEqual(slice.At(i), slice.At(j)) {
+					duplicates[i] = true
+					break
+				}
+			}
+		}
+		i := 0
+		slice.RemoveIf(func(pcommon.Value) bool {
+			duplicate := duplicates[i]
+			i++
+			return duplicate
+		})
+		return nil, nil
+	}, nil
+}
+
+// dedupEqual follows the comparison rules of the OTTL: numbers of different types are compared as float64,
+// and values of any other different types are never equal.
+func dedupEqual(a, b pcommon.Value) bool {
+	if sortRank(a) == 1 && sortRank(b) == 1 {
+		return compareNumbers(ottl.GetValue(a), ottl.GetValue(b)) == 0
+	}
+	return a.Equal(b)
+}
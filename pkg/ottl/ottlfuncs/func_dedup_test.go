@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_dedup(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    func() pcommon.Slice
+		expected []interface{}
+	}{
+		{
+			name: "duplicates removed",
+			input: func() pcommon.Slice {
+				return sliceOf("b", "a", "b", "c", "a")
+			},
+			expected: []interface{}{"b", "a", "c"},
+		},
+		{
+			name: "already unique",
+			input: func() pcommon.Slice {
+				return sliceOf("a", "b", "c")
+			},
+			expected: []interface{}{"a", "b", "c"},
+		},
+		{
+			name: "numbers of different types",
+			input: func() pcommon.Slice {
+				return sliceOf(int64(1), 1.0, 1.5, int64(2), 2.0)
+			},
+			expected: []interface{}{int64(1), 1.5, int64(2)},
+		},
+		{
+			name: "values of different types",
+			input: func() pcommon.Slice {
+				return sliceOf("1", int64(1), true, []byte{1}, "1")
+			},
+			expected: []interface{}{"1", int64(1), true, []byte{1}},
+		},
+		{
+			name: "maps and slices",
+			input: func() pcommon.Slice {
+				s := pcommon.NewSlice()
+				s.AppendEmpty().SetEmptyMap().PutStr("a", "b")
+				s.AppendEmpty().SetEmptyMap().PutStr("a", "c")
+				s.AppendEmpty().SetEmptyMap().PutStr("a", "b")
+				s.AppendEmpty().SetEmptySlice().AppendEmpty().SetStr("a")
+				s.AppendEmpty().SetEmptySlice().AppendEmpty().SetStr("a")
+				return s
+			},
+			expected: []interface{}{
+				map[string]interface{}{"a": "b"},
+				map[string]interface{}{"a": "c"},
+				[]interface{}{"a"},
+			},
+		},
+		{
+			name:     "empty slice",
+			input:    pcommon.NewSlice,
+			expected: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[pcommon.Slice]{
+				Getter: func(ctx pcommon.Slice) (interface{}, error) {
+					return ctx, nil
+				},
+			}
+
+			exprFunc, err := Dedup[pcommon.Slice](target)
+			require.NoError(t, err)
+
+			input := tt.input()
+			result, err := exprFunc(input)
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expected, input.AsRaw())
+		})
+	}
+}
+
+func Test_dedup_bad_input(t *testing.T) {
+	input := pcommon.NewValueStr("not a slice")
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+
+	exprFunc, err := Dedup[interface{}](target)
+	require.NoError(t, err)
+
+	result, err := exprFunc(input)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, pcommon.NewValueStr("not a slice"), input)
+}
@@ -58,6 +58,25 @@ func Test_deleteMatchingKeys(t *testing.T) {
 				expectedMap.PutStr("test", "hello world")
 			},
 		},
+		{
+			name:    "delete only the exact match of an anchored pattern",
+			target:  target,
+			pattern: "^test$",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutInt("test2", 3)
+				expectedMap.PutBool("test3", true)
+			},
+		},
+		{
+			name:    "delete nothing with an anchored pattern matching inside keys only",
+			target:  target,
+			pattern: "^est",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello world")
+				expectedMap.PutInt("test2", 3)
+				expectedMap.PutBool("test3", true)
+			},
+		},
 		{
 			name:    "delete nothing",
 			target:  target,
@@ -88,6 +107,31 @@ func Test_deleteMatchingKeys(t *testing.T) {
 	}
 }
 
+func Test_deleteMatchingKeys_prefix(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("http.request.header.authorization", "secret")
+	input.PutStr("http.request.header.cookie", "secret")
+	input.PutStr("http.request.method", "GET")
+	input.PutStr("x.http.request.header.foo", "bar")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+
+	exprFunc, err := DeleteMatchingKeys[pcommon.Map](target, `^http\.request\.header\.`)
+	require.NoError(t, err)
+
+	_, err = exprFunc(input)
+	require.NoError(t, err)
+
+	expected := pcommon.NewMap()
+	expected.PutStr("http.request.method", "GET")
+	expected.PutStr("x.http.request.header.foo", "bar")
+	assert.Equal(t, expected.AsRaw(), input.AsRaw())
+}
+
 func Test_deleteMatchingKeys_bad_input(t *testing.T) {
 	input := pcommon.NewValueInt(1)
 	target := &ottl.StandardGetSetter[interface{}]{
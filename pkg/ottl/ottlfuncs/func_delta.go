@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// deltaCacheSize is the number of keys whose previous value is kept.
+const deltaCacheSize = 10000
+
+// deltaCache holds the previous value observed for each key. It is shared by all the Delta
+// functions, whose executions can be concurrent. When it is full, the least recently observed key is evicted.
+type deltaCache struct {
+	mu       sync.Mutex
+	size     int
+	order    *list.List
+	previous map[string]*list.Element
+}
+
+type deltaEntry struct {
+	key   string
+	value interface{}
+}
+
+func newDeltaCache(size int) *deltaCache {
+	return &deltaCache{
+		size:     size,
+		order:    list.New(),
+		previous: map[string]*list.Element{},
+	}
+}
+
+// swap stores current as the value of key and returns the previous value, if any.
+func (c *deltaCache) swap(key string, current interface{}) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.previous[key]; ok {
+		entry := element.Value.(*deltaEntry)
+		previous := entry.value
+		entry.value = current
+		c.order.MoveToFront(element)
+		return previous, true
+	}
+	if c.order.Len() >= c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.previous, oldest.Value.(*deltaEntry).key)
+	}
+	c.previous[key] = c.order.PushFront(&deltaEntry{key: key, value: current})
+	return nil, false
+}
+
+var deltas = newDeltaCache(deltaCacheSize)
+
+func Delta[K any](currentValue ottl.Getter[K], key string) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := currentValue.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch val.(type) {
+		case int64, float64:
+		default:
+			return nil, nil
+		}
+
+		previous, ok := deltas.swap(key, val)
+		if !ok {
+			return nil, nil
+		}
+		// a counter lower than its previous value was reset, so it counts from zero again
+		if compareNumbers(val, previous) < 0 {
+			return val, nil
+		}
+		if current, isInt := val.(int64); isInt {
+			if previous, isInt := previous.(int64); isInt {
+				return current - previous, nil
+			}
+		}
+		currentFloat, _ := toFloat64(val)
+		previousFloat, _ := toFloat64(previous)
+		return currentFloat - previousFloat, nil
+	}, nil
+}
@@ -0,0 +1,151 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Delta(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "int counter",
+			values:   []interface{}{int64(10), int64(15), int64(15), int64(22)},
+			expected: []interface{}{nil, int64(5), int64(0), int64(7)},
+		},
+		{
+			name:     "float counter",
+			values:   []interface{}{1.5, 4.0},
+			expected: []interface{}{nil, 2.5},
+		},
+		{
+			name:     "mixed types",
+			values:   []interface{}{int64(1), 3.5},
+			expected: []interface{}{nil, 2.5},
+		},
+		{
+			name:     "counter reset",
+			values:   []interface{}{int64(100), int64(120), int64(8), int64(10)},
+			expected: []interface{}{nil, int64(20), int64(8), int64(2)},
+		},
+		{
+			name:     "non-numeric values are ignored",
+			values:   []interface{}{int64(10), "12", nil, int64(12)},
+			expected: []interface{}{nil, nil, nil, int64(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var current interface{}
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return current, nil
+				},
+			}
+			exprFunc, err := Delta[interface{}](target, "Test_Delta/"+tt.name)
+			require.NoError(t, err)
+			for i, value := range tt.values {
+				current = value
+				result, err := exprFunc(nil)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected[i], result, "value %d", i)
+			}
+		})
+	}
+}
+
+func Test_Delta_keys(t *testing.T) {
+	var current interface{}
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return current, nil
+		},
+	}
+	first, err := Delta[interface{}](target, "Test_Delta_keys/requests")
+	require.NoError(t, err)
+	second, err := Delta[interface{}](target, "Test_Delta_keys/requests")
+	require.NoError(t, err)
+	other, err := Delta[interface{}](target, "Test_Delta_keys/errors")
+	require.NoError(t, err)
+
+	current = int64(10)
+	_, err = first(nil)
+	require.NoError(t, err)
+
+	// functions with the same key share the previous value
+	current = int64(15)
+	result, err := second(nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), result)
+
+	// functions with another key don't
+	result, err = other(nil)
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_deltaCache_eviction(t *testing.T) {
+	cache := newDeltaCache(2)
+	_, ok := cache.swap("a", int64(1))
+	assert.False(t, ok)
+	_, ok = cache.swap("b", int64(2))
+	assert.False(t, ok)
+
+	// a is observed again, so b is now the least recently observed key
+	previous, ok := cache.swap("a", int64(3))
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), previous)
+
+	_, ok = cache.swap("c", int64(4))
+	assert.False(t, ok)
+	assert.Len(t, cache.previous, 2)
+
+	_, ok = cache.swap("b", int64(5))
+	assert.False(t, ok, "b should have been evicted")
+	previous, ok = cache.swap("c", int64(6))
+	assert.True(t, ok)
+	assert.Equal(t, int64(4), previous)
+}
+
+func Test_Delta_concurrent(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+	exprFunc, err := Delta[interface{}](target, "Test_Delta_concurrent")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := exprFunc(int64(i))
+			assert.NoError(t, err)
+		}(i)
+	}
+	wg.Wait()
+}
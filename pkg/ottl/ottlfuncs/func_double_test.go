@@ -0,0 +1,100 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Double(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       interface{}
+		expected    interface{}
+		expectedErr string
+	}{
+		{
+			name:     "string",
+			value:    "2.5",
+			expected: float64(2.5),
+		},
+		{
+			name:     "int string",
+			value:    "50",
+			expected: float64(50),
+		},
+		{
+			name:        "empty string",
+			value:       "",
+			expectedErr: `could not convert "" to a double`,
+		},
+		{
+			name:        "not a number string",
+			value:       "test",
+			expectedErr: `could not convert "test" to a double`,
+		},
+		{
+			name:     "int64",
+			value:    int64(333),
+			expected: float64(333),
+		},
+		{
+			name:     "float64",
+			value:    float64(2.7),
+			expected: float64(2.7),
+		},
+		{
+			name:     "true",
+			value:    true,
+			expected: float64(1),
+		},
+		{
+			name:     "false",
+			value:    false,
+			expected: float64(0),
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "some struct",
+			value:    struct{}{},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Double[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
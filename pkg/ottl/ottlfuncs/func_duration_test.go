@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Duration(t *testing.T) {
+	start := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		start    interface{}
+		end      interface{}
+		expected interface{}
+	}{
+		{
+			name:     "interval",
+			start:    start,
+			end:      start.Add(1500 * time.Millisecond),
+			expected: int64(1500000000),
+		},
+		{
+			name:     "equal times",
+			start:    start,
+			end:      start,
+			expected: int64(0),
+		},
+		{
+			name:     "end before start",
+			start:    start,
+			end:      start.Add(-time.Second),
+			expected: int64(-1000000000),
+		},
+		{
+			name:     "non-time start",
+			start:    "2022-11-01T12:00:00Z",
+			end:      start,
+			expected: nil,
+		},
+		{
+			name:     "non-time end",
+			start:    start,
+			end:      int64(1667304000),
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			start:    nil,
+			end:      start,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startGetter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.start, nil
+				},
+			}
+			endGetter := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.end, nil
+				},
+			}
+			exprFunc, err := Duration[interface{}](startGetter, endGetter)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Fingerprint[K any](fields ...ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		hash := sha256.New()
+		for _, field := range fields {
+			val, err := field.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			data, err := fingerprintBytes(val)
+			if err != nil {
+				return nil, err
+			}
+			// Each field is written with its kind and length, so that neither ("ab", "c") and ("a", "bc")
+			// nor nil and "<nil>" produce the same fingerprint.
+			var header [9]byte
+			if val == nil {
+				header[0] = 1
+			}
+			binary.BigEndian.PutUint64(header[1:], uint64(len(data)))
+			hash.Write(header[:])
+			hash.Write(data)
+		}
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}, nil
+}
+
+func fingerprintBytes(val interface{}) ([]byte, error) {
+	switch v := val.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []byte(v), nil
+	case []byte:
+		return v, nil
+	case pcommon.Map:
+		return json.Marshal(v.AsRaw())
+	case pcommon.Slice:
+		return json.Marshal(v.AsRaw())
+	case pcommon.Value:
+		return json.Marshal(v.AsRaw())
+	default:
+		return []byte(fmt.Sprint(v)), nil
+	}
+}
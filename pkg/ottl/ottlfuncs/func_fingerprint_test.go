@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_fingerprint(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []interface{}
+		second []interface{}
+		same   bool
+	}{
+		{
+			name:   "same strings",
+			first:  []interface{}{"a", "b"},
+			second: []interface{}{"a", "b"},
+			same:   true,
+		},
+		{
+			name:   "same mixed types",
+			first:  []interface{}{"a", int64(1), 1.5, true, nil, []byte{1, 2}},
+			second: []interface{}{"a", int64(1), 1.5, true, nil, []byte{1, 2}},
+			same:   true,
+		},
+		{
+			name:   "same maps",
+			first:  []interface{}{fingerprintMap(map[string]interface{}{"a": "b", "c": int64(1)})},
+			second: []interface{}{fingerprintMap(map[string]interface{}{"c": int64(1), "a": "b"})},
+			same:   true,
+		},
+		{
+			name:   "different values",
+			first:  []interface{}{"a", "b"},
+			second: []interface{}{"a", "c"},
+		},
+		{
+			name:   "order matters",
+			first:  []interface{}{"a", "b"},
+			second: []interface{}{"b", "a"},
+		},
+		{
+			name:   "values aren't merged",
+			first:  []interface{}{"ab", "c"},
+			second: []interface{}{"a", "bc"},
+		},
+		{
+			name:   "nil differs from its string form",
+			first:  []interface{}{nil},
+			second: []interface{}{"<nil>"},
+		},
+		{
+			name:   "nil differs from an empty string",
+			first:  []interface{}{nil},
+			second: []interface{}{""},
+		},
+		{
+			name:   "different maps",
+			first:  []interface{}{fingerprintMap(map[string]interface{}{"a": "b"})},
+			second: []interface{}{fingerprintMap(map[string]interface{}{"a": "c"})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := fingerprintOf(t, tt.first...)
+			second := fingerprintOf(t, tt.second...)
+			assert.Len(t, first, 64)
+			if tt.same {
+				assert.Equal(t, first, second)
+			} else {
+				assert.NotEqual(t, first, second)
+			}
+		})
+	}
+}
+
+func Test_fingerprint_stable(t *testing.T) {
+	// The fingerprint is used as a key outside of the collector, it must not change across versions.
+	assert.Equal(t, "843539ed7f54e35eea77ab59d890aa1a43d20dc467371dcf25cd2dd1b5a2cc69", fingerprintOf(t, "a", int64(1)))
+}
+
+func fingerprintOf(t *testing.T, vals ...interface{}) interface{} {
+	fields := make([]ottl.Getter[interface{}], 0, len(vals))
+	for _, val := range vals {
+		val := val
+		fields = append(fields, &ottl.StandardGetSetter[interface{}]{
+			Getter: func(ctx interface{}) (interface{}, error) {
+				return val, nil
+			},
+		})
+	}
+	exprFunc, err := Fingerprint[interface{}](fields...)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	require.NoError(t, err)
+	return result
+}
+
+func fingerprintMap(raw map[string]interface{}) pcommon.Map {
+	m := pcommon.NewMap()
+	m.FromRaw(raw)
+	return m
+}
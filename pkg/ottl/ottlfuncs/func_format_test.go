@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		args     []interface{}
+		expected string
+	}{
+		{
+			name:     "string verbs",
+			format:   "%s-%s",
+			args:     []interface{}{"hello", "world"},
+			expected: "hello-world",
+		},
+		{
+			name:     "numeric verbs",
+			format:   "%d/%.2f",
+			args:     []interface{}{int64(10), 1.5},
+			expected: "10/1.50",
+		},
+		{
+			name:     "no args",
+			format:   "hello",
+			expected: "hello",
+		},
+		{
+			name:     "missing arg",
+			format:   "%s-%d",
+			args:     []interface{}{"hello"},
+			expected: "hello-%!d(MISSING)",
+		},
+		{
+			name:     "extra arg",
+			format:   "%s",
+			args:     []interface{}{"hello", int64(1)},
+			expected: "hello%!(EXTRA int64=1)",
+		},
+		{
+			name:     "nil arg",
+			format:   "%v",
+			args:     []interface{}{nil},
+			expected: "<nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var getters []ottl.Getter[interface{}]
+			for _, arg := range tt.args {
+				val := arg
+				getters = append(getters, &ottl.StandardGetSetter[interface{}]{
+					Getter: func(interface{}) (interface{}, error) {
+						return val, nil
+					},
+				})
+			}
+			exprFunc, err := Format[interface{}](tt.format, getters...)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_GetOrDefault(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("str", "value")
+	input.PutInt("int", 1)
+	input.PutEmpty("empty")
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		key      string
+		def      interface{}
+		expected interface{}
+	}{
+		{
+			name:     "present key",
+			target:   input,
+			key:      "str",
+			def:      "default",
+			expected: "value",
+		},
+		{
+			name:     "present int key",
+			target:   input,
+			key:      "int",
+			def:      int64(0),
+			expected: int64(1),
+		},
+		{
+			name:     "present key without a value",
+			target:   input,
+			key:      "empty",
+			def:      "default",
+			expected: nil,
+		},
+		{
+			name:     "absent key",
+			target:   input,
+			key:      "missing",
+			def:      "default",
+			expected: "default",
+		},
+		{
+			name:     "absent key with nil default",
+			target:   input,
+			key:      "missing",
+			def:      nil,
+			expected: nil,
+		},
+		{
+			name:     "non-map target",
+			target:   "not a map",
+			key:      "str",
+			def:      "default",
+			expected: "default",
+		},
+		{
+			name:     "nil target",
+			target:   nil,
+			key:      "str",
+			def:      "default",
+			expected: "default",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := GetOrDefault[interface{}](
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(interface{}) (interface{}, error) {
+						return tt.target, nil
+					},
+				},
+				tt.key,
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(interface{}) (interface{}, error) {
+						return tt.def, nil
+					},
+				},
+			)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_GetOrDefault_default_not_evaluated(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("str", "value")
+
+	exprFunc, err := GetOrDefault[interface{}](
+		&ottl.StandardGetSetter[interface{}]{
+			Getter: func(interface{}) (interface{}, error) {
+				return input, nil
+			},
+		},
+		"str",
+		&ottl.StandardGetSetter[interface{}]{
+			Getter: func(interface{}) (interface{}, error) {
+				t.Errorf("the default should not be evaluated when the key is present")
+				return nil, nil
+			},
+		},
+	)
+	assert.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", result)
+}
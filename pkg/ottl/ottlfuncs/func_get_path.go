@@ -0,0 +1,66 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func GetPath[K any](target ottl.Getter[K], path string) (ottl.ExprFunc[K], error) {
+	if path == "" {
+		return nil, fmt.Errorf("the path supplied to GetPath must not be empty")
+	}
+	segments := strings.Split(path, ".")
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attrs, ok := val.(pcommon.Map)
+		if !ok {
+			return nil, nil
+		}
+
+		leaf, ok := attrs.Get(segments[0])
+		if !ok {
+			return nil, nil
+		}
+		for _, segment := range segments[1:] {
+			switch leaf.Type() {
+			case pcommon.ValueTypeMap:
+				leaf, ok = leaf.Map().Get(segment)
+				if !ok {
+					return nil, nil
+				}
+			case pcommon.ValueTypeSlice:
+				index, err := strconv.Atoi(segment)
+				if err != nil || index < 0 || index >= leaf.Slice().Len() {
+					return nil, nil
+				}
+				leaf = leaf.Slice().At(index)
+			default:
+				return nil, nil
+			}
+		}
+		return ottl.GetValue(leaf), nil
+	}, nil
+}
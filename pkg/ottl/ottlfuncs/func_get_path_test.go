@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_GetPath(t *testing.T) {
+	input := pcommon.NewMap()
+	a := input.PutEmptyMap("a")
+	b := a.PutEmptyMap("b")
+	b.PutStr("c", "deep")
+	b.PutInt("n", 5)
+	list := a.PutEmptySlice("list")
+	list.AppendEmpty().SetEmptyMap().PutStr("name", "first")
+	list.AppendEmpty().SetStr("second")
+	input.PutStr("top", "value")
+	input.PutStr("dotted.key", "unreachable")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected interface{}
+	}{
+		{
+			name:     "top level key",
+			path:     "top",
+			expected: "value",
+		},
+		{
+			name:     "deep hit",
+			path:     "a.b.c",
+			expected: "deep",
+		},
+		{
+			name:     "deep int",
+			path:     "a.b.n",
+			expected: int64(5),
+		},
+		{
+			name:     "map leaf",
+			path:     "a.b",
+			expected: b,
+		},
+		{
+			name:     "missing top level key",
+			path:     "missing",
+			expected: nil,
+		},
+		{
+			name:     "missing middle segment",
+			path:     "a.missing.c",
+			expected: nil,
+		},
+		{
+			name:     "path through a string",
+			path:     "top.c",
+			expected: nil,
+		},
+		{
+			name:     "array index",
+			path:     "a.list.0.name",
+			expected: "first",
+		},
+		{
+			name:     "array index leaf",
+			path:     "a.list.1",
+			expected: "second",
+		},
+		{
+			name:     "array index out of range",
+			path:     "a.list.2",
+			expected: nil,
+		},
+		{
+			name:     "non-numeric array index",
+			path:     "a.list.first",
+			expected: nil,
+		},
+		{
+			name:     "keys containing dots are not addressable",
+			path:     "dotted.key",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := GetPath[pcommon.Map](target, tt.path)
+			assert.NoError(t, err)
+			result, err := exprFunc(input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_GetPath_bad_input(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(interface{}) (interface{}, error) {
+			return "not a map", nil
+		},
+	}
+
+	exprFunc, err := GetPath[interface{}](target, "a.b")
+	assert.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_GetPath_empty_path(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(interface{}) (interface{}, error) {
+			return pcommon.NewMap(), nil
+		},
+	}
+
+	_, err := GetPath[interface{}](target, "")
+	assert.EqualError(t, err, "the path supplied to GetPath must not be empty")
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// gunzipMaxSize is the maximum size of the data decompressed by Gunzip, which protects the
+// collector from gzip bombs.
+const gunzipMaxSize = 16 << 20
+
+func Gunzip[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case string:
+			return gunzipBytes([]byte(v))
+		case []byte:
+			return gunzipBytes(v)
+		default:
+			return nil, nil
+		}
+	}, nil
+}
+
+func gunzipBytes(data []byte) (interface{}, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress gzip data: %w", err)
+	}
+	defer reader.Close()
+	// one more byte than allowed is read to tell data of the maximum size from larger data
+	decompressed, err := io.ReadAll(io.LimitReader(reader, gunzipMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress gzip data: %w", err)
+	}
+	if len(decompressed) > gunzipMaxSize {
+		return nil, fmt.Errorf("could not decompress gzip data: the decompressed data is larger than %d bytes", gunzipMaxSize)
+	}
+	return string(decompressed), nil
+}
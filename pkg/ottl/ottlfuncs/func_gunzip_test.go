@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Gunzip(t *testing.T) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "bytes",
+			value:    buf.Bytes(),
+			expected: "hello world",
+		},
+		{
+			name:     "string",
+			value:    buf.String(),
+			expected: "hello world",
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "int target",
+			value:    int64(1),
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Gunzip[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Gunzip_round_trip(t *testing.T) {
+	for _, value := range []string{"", "a", "hello world", string(bytes.Repeat([]byte("abc"), 10000))} {
+		target := &ottl.StandardGetSetter[interface{}]{
+			Getter: func(ctx interface{}) (interface{}, error) {
+				return value, nil
+			},
+		}
+		compress, err := Gzip[interface{}](target)
+		require.NoError(t, err)
+		decompress, err := Gunzip[interface{}](&ottl.StandardGetSetter[interface{}]{Getter: compress})
+		require.NoError(t, err)
+
+		result, err := decompress(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, value, result)
+	}
+}
+
+func Test_Gunzip_invalid(t *testing.T) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte("hello world"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	tests := []struct {
+		name          string
+		value         interface{}
+		expectedError string
+	}{
+		{
+			name:          "not gzip",
+			value:         "hello world",
+			expectedError: "could not decompress gzip data: gzip: invalid header",
+		},
+		{
+			name:          "empty",
+			value:         []byte{},
+			expectedError: "could not decompress gzip data: EOF",
+		},
+		{
+			name:          "truncated",
+			value:         buf.Bytes()[:buf.Len()-4],
+			expectedError: "could not decompress gzip data: unexpected EOF",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Gunzip[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.EqualError(t, err, tt.expectedError)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_Gunzip_max_size(t *testing.T) {
+	tests := []struct {
+		name          string
+		size          int
+		expectedError string
+	}{
+		{
+			name: "maximum size",
+			size: gunzipMaxSize,
+		},
+		{
+			name:          "larger than the maximum size",
+			size:          gunzipMaxSize + 1,
+			expectedError: "could not decompress gzip data: the decompressed data is larger than 16777216 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// zeros are compressed to a small fraction of their size, like in gzip bombs
+			var buf bytes.Buffer
+			writer := gzip.NewWriter(&buf)
+			_, err := writer.Write(make([]byte, tt.size))
+			require.NoError(t, err)
+			require.NoError(t, writer.Close())
+			require.Less(t, buf.Len(), 64<<10)
+
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return buf.Bytes(), nil
+				},
+			}
+			exprFunc, err := Gunzip[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, result, tt.size)
+		})
+	}
+}
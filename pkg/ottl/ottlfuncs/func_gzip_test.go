@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Gzip(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string",
+			value:    "hello world",
+			expected: []byte("hello world"),
+		},
+		{
+			name:     "bytes",
+			value:    []byte{0x00, 0x01, 0xff},
+			expected: []byte{0x00, 0x01, 0xff},
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: []byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := Gzip[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			require.NoError(t, err)
+			require.IsType(t, []byte{}, result)
+
+			// the result is decompressed with the standard library to check it's valid gzip
+			reader, err := gzip.NewReader(bytes.NewReader(result.([]byte)))
+			require.NoError(t, err)
+			decompressed, err := io.ReadAll(reader)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, decompressed)
+		})
+	}
+}
+
+func Test_Gzip_bad_input(t *testing.T) {
+	for _, value := range []interface{}{nil, int64(1), true} {
+		target := &ottl.StandardGetSetter[interface{}]{
+			Getter: func(ctx interface{}) (interface{}, error) {
+				return value, nil
+			},
+		}
+		exprFunc, err := Gzip[interface{}](target)
+		require.NoError(t, err)
+		result, err := exprFunc(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, result)
+	}
+}
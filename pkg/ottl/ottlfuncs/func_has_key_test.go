@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+)
+
+func Test_HasKey(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutInt("http.status_code", 200)
+	input.PutEmpty("empty")
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		key      string
+		expected bool
+	}{
+		{
+			name:     "present key",
+			value:    input,
+			key:      "http.status_code",
+			expected: true,
+		},
+		{
+			name:     "present key with empty value",
+			value:    input,
+			key:      "empty",
+			expected: true,
+		},
+		{
+			name:     "absent key",
+			value:    input,
+			key:      "http.method",
+			expected: false,
+		},
+		{
+			name:     "non-map target",
+			value:    "http.status_code",
+			key:      "http.status_code",
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			key:      "http.status_code",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := HasKey[interface{}](target, tt.key)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HasKey_condition(t *testing.T) {
+	parser, err := ottllogs.NewParser(map[string]interface{}{
+		"HasKey": HasKey[ottllogs.TransformContext],
+		"set":    Set[ottllogs.TransformContext],
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{`set(attributes["has_status"], true) where HasKey(attributes, "http.status_code")`})
+	require.NoError(t, err)
+
+	for _, present := range []bool{true, false} {
+		logRecord := plog.NewLogRecord()
+		if present {
+			logRecord.Attributes().PutInt("http.status_code", 200)
+		}
+		ctx := ottllogs.NewTransformContext(logRecord, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+		_, _, err = statements[0].Execute(ctx)
+		require.NoError(t, err)
+		_, ok := logRecord.Attributes().Get("has_status")
+		assert.Equal(t, present, ok)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+)
+
+func Test_HasPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		prefix   string
+		expected bool
+	}{
+		{
+			name:     "has prefix",
+			value:    "debug.request",
+			prefix:   "debug.",
+			expected: true,
+		},
+		{
+			name:     "doesn't have prefix",
+			value:    "request.debug.",
+			prefix:   "debug.",
+			expected: false,
+		},
+		{
+			name:     "empty prefix",
+			value:    "request",
+			prefix:   "",
+			expected: true,
+		},
+		{
+			name:     "non-string target",
+			value:    int64(1),
+			prefix:   "1",
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			prefix:   "debug.",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := HasPrefix[interface{}](target, tt.prefix)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HasPrefix_condition(t *testing.T) {
+	parser, err := ottllogs.NewParser(map[string]interface{}{
+		"HasPrefix": HasPrefix[ottllogs.TransformContext],
+		"set":       Set[ottllogs.TransformContext],
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{`set(attributes["debug"], true) where HasPrefix(body, "debug.")`})
+	require.NoError(t, err)
+
+	for body, expected := range map[string]bool{"debug.request": true, "request": false} {
+		logRecord := plog.NewLogRecord()
+		logRecord.Body().SetStr(body)
+		ctx := ottllogs.NewTransformContext(logRecord, pcommon.NewInstrumentationScope(), pcommon.NewResource())
+		_, _, err = statements[0].Execute(ctx)
+		require.NoError(t, err)
+		_, ok := logRecord.Attributes().Get("debug")
+		assert.Equal(t, expected, ok)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func HashSample[K any](target ottl.Getter[K], percent float64) (ottl.ExprFunc[K], error) {
+	if percent < 0 || percent > 100 {
+		return nil, fmt.Errorf("the percent supplied to HashSample must be between 0 and 100, got %v", percent)
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var data []byte
+		switch v := val.(type) {
+		case string:
+			data = []byte(v)
+		case []byte:
+			data = v
+		case pcommon.TraceID:
+			data = v[:]
+		case pcommon.SpanID:
+			data = v[:]
+		default:
+			return false, nil
+		}
+		sum := sha256.Sum256(data)
+		// Map the first 8 bytes of the hash uniformly onto [0, 100).
+		bucket := float64(binary.BigEndian.Uint64(sum[:8])) / (math.MaxUint64 + 1.0) * 100
+		return bucket < percent, nil
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_hashSample(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+
+	tests := []struct {
+		name     string
+		percent  float64
+		inputs   []interface{}
+		expected bool
+	}{
+		{
+			name:     "zero percent never samples",
+			percent:  0,
+			inputs:   []interface{}{"a", "b", []byte{1, 2}, pcommon.TraceID([16]byte{1})},
+			expected: false,
+		},
+		{
+			name:     "hundred percent always samples",
+			percent:  100,
+			inputs:   []interface{}{"a", "b", []byte{1, 2}, pcommon.TraceID([16]byte{1}), pcommon.SpanID([8]byte{1})},
+			expected: true,
+		},
+		{
+			name:     "unsupported type is never sampled",
+			percent:  100,
+			inputs:   []interface{}{nil, int64(1), true},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := HashSample[interface{}](target, tt.percent)
+			require.NoError(t, err)
+			for _, input := range tt.inputs {
+				result, err := exprFunc(input)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func Test_hashSample_deterministic(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+	exprFunc, err := HashSample[interface{}](target, 50)
+	require.NoError(t, err)
+
+	sampled := 0
+	for i := 0; i < 1000; i++ {
+		input := fmt.Sprintf("trace-%d", i)
+		first, err := exprFunc(input)
+		require.NoError(t, err)
+		for j := 0; j < 3; j++ {
+			again, err := exprFunc(input)
+			require.NoError(t, err)
+			assert.Equal(t, first, again)
+		}
+		if first.(bool) {
+			sampled++
+		}
+	}
+	// The hash distributes inputs uniformly, so roughly half of them are sampled.
+	assert.InDelta(t, 500, sampled, 100)
+}
+
+func Test_hashSample_validation(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			t.Errorf("nothing should be received in this scenario")
+			return nil, nil
+		},
+	}
+	for _, percent := range []float64{-1, 100.1} {
+		_, err := HashSample[interface{}](target, percent)
+		assert.Error(t, err)
+	}
+}
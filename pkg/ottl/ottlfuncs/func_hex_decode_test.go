@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_HexDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "lowercase",
+			value:    "01abff",
+			expected: []byte{0x01, 0xab, 0xff},
+		},
+		{
+			name:     "uppercase",
+			value:    "01ABFF",
+			expected: []byte{0x01, 0xab, 0xff},
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: []byte{},
+		},
+		{
+			name:     "bytes target",
+			value:    []byte("01"),
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := HexDecode[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_HexDecode_round_trip(t *testing.T) {
+	for _, value := range [][]byte{{}, {0x00}, {0x01, 0x02, 0x03, 0x04}, []byte("hello world")} {
+		target := &ottl.StandardGetSetter[interface{}]{
+			Getter: func(ctx interface{}) (interface{}, error) {
+				return value, nil
+			},
+		}
+		encode, err := HexEncode[interface{}](target)
+		require.NoError(t, err)
+		decode, err := HexDecode[interface{}](&ottl.StandardGetSetter[interface{}]{Getter: encode})
+		require.NoError(t, err)
+
+		result, err := decode(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, value, result)
+	}
+}
+
+func Test_HexDecode_invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		value         string
+		expectedError string
+	}{
+		{
+			name:          "odd length",
+			value:         "abc",
+			expectedError: "could not decode hex string: encoding/hex: odd length hex string",
+		},
+		{
+			name:          "non-hex characters",
+			value:         "zz",
+			expectedError: "could not decode hex string: encoding/hex: invalid byte: U+007A 'z'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := HexDecode[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.EqualError(t, err, tt.expectedError)
+			assert.Nil(t, result)
+		})
+	}
+}
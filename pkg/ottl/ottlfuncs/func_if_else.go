@@ -0,0 +1,39 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func IfElse[K any](cond ottl.Getter[K], thenVal ottl.Getter[K], elseVal ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := cond.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		b, ok := val.(bool)
+		if !ok {
+			return nil, fmt.Errorf("the condition of IfElse must be a bool, got %T", val)
+		}
+		// Only the selected branch is evaluated.
+		if b {
+			return thenVal.Get(ctx)
+		}
+		return elseVal.Get(ctx)
+	}, nil
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ifElse(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     interface{}
+		expected interface{}
+	}{
+		{
+			name:     "true condition",
+			cond:     true,
+			expected: "then",
+		},
+		{
+			name:     "false condition",
+			cond:     false,
+			expected: "else",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var thenCount, elseCount int
+			cond := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.cond, nil
+				},
+			}
+			thenVal := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					thenCount++
+					return "then", nil
+				},
+			}
+			elseVal := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					elseCount++
+					return "else", nil
+				},
+			}
+
+			exprFunc, err := IfElse[interface{}](cond, thenVal, elseVal)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+
+			// the branch that isn't taken must not be evaluated
+			if tt.cond == true {
+				assert.Equal(t, 1, thenCount)
+				assert.Equal(t, 0, elseCount)
+			} else {
+				assert.Equal(t, 0, thenCount)
+				assert.Equal(t, 1, elseCount)
+			}
+		})
+	}
+}
+
+func Test_ifElse_error(t *testing.T) {
+	tests := []struct {
+		name     string
+		cond     interface{}
+		expected string
+	}{
+		{
+			name:     "string condition",
+			cond:     "true",
+			expected: "the condition of IfElse must be a bool, got string",
+		},
+		{
+			name:     "nil condition",
+			cond:     nil,
+			expected: "the condition of IfElse must be a bool, got <nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.cond, nil
+				},
+			}
+			branch := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					t.Fatal("no branch must be evaluated")
+					return nil, nil
+				},
+			}
+
+			exprFunc, err := IfElse[interface{}](cond, branch, branch)
+			require.NoError(t, err)
+			_, err = exprFunc(nil)
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
+}
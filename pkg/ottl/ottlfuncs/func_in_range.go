@@ -0,0 +1,43 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func InRange[K any](target ottl.Getter[K], low float64, high float64) (ottl.ExprFunc[K], error) {
+	if low > high {
+		return nil, fmt.Errorf("the low bound supplied to InRange can't be greater than the high bound, got %v and %v", low, high)
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var f float64
+		switch v := val.(type) {
+		case int64:
+			f = float64(v)
+		case float64:
+			f = v
+		default:
+			return false, nil
+		}
+		return low <= f && f <= high, nil
+	}, nil
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_InRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		low      float64
+		high     float64
+		expected bool
+	}{
+		{
+			name:     "int inside",
+			value:    int64(250),
+			low:      200,
+			high:     299,
+			expected: true,
+		},
+		{
+			name:     "float inside",
+			value:    0.5,
+			low:      0,
+			high:     1,
+			expected: true,
+		},
+		{
+			name:     "low boundary",
+			value:    int64(200),
+			low:      200,
+			high:     299,
+			expected: true,
+		},
+		{
+			name:     "high boundary",
+			value:    299.0,
+			low:      200,
+			high:     299,
+			expected: true,
+		},
+		{
+			name:     "single value range",
+			value:    int64(1),
+			low:      1,
+			high:     1,
+			expected: true,
+		},
+		{
+			name:     "below",
+			value:    int64(199),
+			low:      200,
+			high:     299,
+			expected: false,
+		},
+		{
+			name:     "above",
+			value:    299.5,
+			low:      200,
+			high:     299,
+			expected: false,
+		},
+		{
+			name:     "NaN",
+			value:    math.NaN(),
+			low:      0,
+			high:     1,
+			expected: false,
+		},
+		{
+			name:     "non-numeric target",
+			value:    "250",
+			low:      200,
+			high:     299,
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			low:      200,
+			high:     299,
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := InRange[interface{}](target, tt.low, tt.high)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_InRange_low_greater_than_high(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return int64(1), nil
+		},
+	}
+	exprFunc, err := InRange[interface{}](target, 2, 1)
+	assert.EqualError(t, err, "the low bound supplied to InRange can't be greater than the high bound, got 2 and 1")
+	assert.Nil(t, exprFunc)
+}
@@ -15,6 +15,7 @@
 package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
@@ -31,11 +32,14 @@ func Int[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
 			return value, nil
 		case string:
 			intValue, err := strconv.ParseInt(value, 10, 64)
+			if err == nil {
+				return intValue, nil
+			}
+			floatValue, err := strconv.ParseFloat(value, 64)
 			if err != nil {
-				return nil, nil
+				return nil, fmt.Errorf("could not convert %q to an int: %w", value, err)
 			}
-
-			return intValue, nil
+			return (int64)(floatValue), nil
 		case float64:
 			return (int64)(value), nil
 		case bool:
@@ -24,9 +24,10 @@ import (
 
 func Test_Int(t *testing.T) {
 	tests := []struct {
-		name     string
-		value    interface{}
-		expected interface{}
+		name        string
+		value       interface{}
+		expected    interface{}
+		expectedErr string
 	}{
 		{
 			name:     "string",
@@ -34,14 +35,19 @@ func Test_Int(t *testing.T) {
 			expected: int64(50),
 		},
 		{
-			name:     "empty string",
-			value:    "",
-			expected: nil,
+			name:     "float string",
+			value:    "2.7",
+			expected: int64(2),
 		},
 		{
-			name:     "not a number string",
-			value:    "test",
-			expected: nil,
+			name:        "empty string",
+			value:       "",
+			expectedErr: `could not convert "" to an int`,
+		},
+		{
+			name:        "not a number string",
+			value:       "test",
+			expectedErr: `could not convert "test" to an int`,
 		},
 		{
 			name:     "int64",
@@ -88,6 +94,10 @@ func Test_Int(t *testing.T) {
 			})
 			assert.NoError(t, err)
 			result, err := exprFunc(nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
 			assert.NoError(t, err)
 			assert.Equal(t, tt.expected, result)
 		})
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_isIPInRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   ottl.Getter[interface{}]
+		cidrs    []string
+		expected bool
+	}{
+		{
+			name: "in range",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "10.1.2.3", nil
+				},
+			},
+			cidrs:    []string{"10.0.0.0/8"},
+			expected: true,
+		},
+		{
+			name: "in second range",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "192.168.1.1", nil
+				},
+			},
+			cidrs:    []string{"10.0.0.0/8", "192.168.0.0/16"},
+			expected: true,
+		},
+		{
+			name: "out of range",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "11.0.0.1", nil
+				},
+			},
+			cidrs:    []string{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name: "IPv6 in range",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "2001:db8::1", nil
+				},
+			},
+			cidrs:    []string{"2001:db8::/32"},
+			expected: true,
+		},
+		{
+			name: "IPv6 out of range",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "2001:db9::1", nil
+				},
+			},
+			cidrs:    []string{"2001:db8::/32"},
+			expected: false,
+		},
+		{
+			name: "not an IP",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "localhost", nil
+				},
+			},
+			cidrs:    []string{"10.0.0.0/8"},
+			expected: false,
+		},
+		{
+			name: "target not a string",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return 10, nil
+				},
+			},
+			cidrs:    []string{"10.0.0.0/8"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := IsIPInRange(tt.target, tt.cidrs)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_isIPInRange_validation(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			t.Errorf("nothing should be received in this scenario")
+			return nil, nil
+		},
+	}
+	_, err := IsIPInRange[interface{}](target, []string{"10.0.0.0/8", "10.0.0.0/33"})
+	assert.ErrorContains(t, err, "invalid CIDR address")
+}
@@ -60,6 +60,16 @@ func Test_isMatch(t *testing.T) {
 			pattern:  "[-+]?\\d*\\.\\d+([eE][-+]?\\d+)?",
 			expected: true,
 		},
+		{
+			name: "replace match case insensitive",
+			target: &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "HELLO world", nil
+				},
+			},
+			pattern:  "(?i)^hello",
+			expected: true,
+		},
 		{
 			name: "target not a string",
 			target: &ottl.StandardGetSetter[interface{}]{
@@ -101,3 +111,16 @@ func Test_isMatch_validation(t *testing.T) {
 	_, err := IsMatch[interface{}](target, "\\K")
 	require.Error(t, err)
 }
+
+func Test_isMatch_lookahead(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "anything", nil
+		},
+	}
+	_, err := IsMatch[interface{}](target, "^foo(?!bar)")
+	assert.EqualError(t, err, "the pattern supplied to IsMatch is not a valid regexp pattern: "+
+		"error parsing regexp: invalid or unsupported Perl syntax: `(?!`; "+
+		"lookarounds such as (?=...), (?!...), (?<=...) and (?<!...) are not supported by the RE2 syntax, "+
+		"rewrite the pattern to match the surrounding text instead, or use a separate negated match")
+}
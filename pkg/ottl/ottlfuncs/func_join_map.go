@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func JoinMap[K any](target ottl.Getter[K], kvSep string, pairSep string) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		m, ok := val.(pcommon.Map)
+		if !ok {
+			return nil, nil
+		}
+
+		keys := make([]string, 0, m.Len())
+		m.Range(func(k string, _ pcommon.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		sort.Strings(keys)
+
+		var sb strings.Builder
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteString(pairSep)
+			}
+			v, _ := m.Get(k)
+			sb.WriteString(k)
+			sb.WriteString(kvSep)
+			sb.WriteString(v.AsString())
+		}
+		return sb.String(), nil
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_JoinMap(t *testing.T) {
+	populated := pcommon.NewMap()
+	populated.PutStr("service", "checkout")
+	populated.PutInt("attempt", 2)
+	populated.PutBool("retry", true)
+	populated.PutDouble("ratio", 0.5)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		kvSep    string
+		pairSep  string
+		expected interface{}
+	}{
+		{
+			name:     "populated map",
+			value:    populated,
+			kvSep:    "=",
+			pairSep:  ",",
+			expected: "attempt=2,ratio=0.5,retry=true,service=checkout",
+		},
+		{
+			name:     "custom separators",
+			value:    populated,
+			kvSep:    ": ",
+			pairSep:  "; ",
+			expected: "attempt: 2; ratio: 0.5; retry: true; service: checkout",
+		},
+		{
+			name:     "empty map",
+			value:    pcommon.NewMap(),
+			kvSep:    "=",
+			pairSep:  ",",
+			expected: "",
+		},
+		{
+			name:     "non-map target",
+			value:    "service=checkout",
+			kvSep:    "=",
+			pairSep:  ",",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			kvSep:    "=",
+			pairSep:  ",",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := JoinMap[interface{}](target, tt.kvSep, tt.pairSep)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_JoinMap_stable(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			m := pcommon.NewMap()
+			for _, k := range []string{"c", "a", "d", "b"} {
+				m.PutStr(k, k)
+			}
+			return m, nil
+		},
+	}
+	exprFunc, err := JoinMap[interface{}](target, "=", "&")
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		result, err := exprFunc(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "a=a&b=b&c=c&d=d", result)
+	}
+}
@@ -80,6 +80,14 @@ func Test_keepKeys(t *testing.T) {
 			keys:   []string{"no match"},
 			want:   func(expectedMap pcommon.Map) {},
 		},
+		{
+			name:   "keys that don't exist are ignored",
+			target: target,
+			keys:   []string{"test", "no match"},
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello world")
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func KVListToMap[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := val.(pcommon.Slice)
+		if !ok {
+			return nil, nil
+		}
+
+		m := pcommon.NewMap()
+		m.EnsureCapacity(list.Len())
+		for i := 0; i < list.Len(); i++ {
+			elem := list.At(i)
+			if elem.Type() != pcommon.ValueTypeMap {
+				return nil, fmt.Errorf("the element at index %d of the KV list isn't a map", i)
+			}
+			key, ok := elem.Map().Get("key")
+			if !ok || key.Type() != pcommon.ValueTypeStr {
+				return nil, fmt.Errorf("the element at index %d of the KV list doesn't have a string \"key\"", i)
+			}
+			value, ok := elem.Map().Get("value")
+			if !ok {
+				return nil, fmt.Errorf("the element at index %d of the KV list doesn't have a \"value\"", i)
+			}
+			// later elements override earlier elements with the same key
+			value.CopyTo(m.PutEmpty(key.Str()))
+		}
+		return m, nil
+	}, nil
+}
@@ -0,0 +1,166 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func kvList(raw []interface{}) pcommon.Slice {
+	s := pcommon.NewSlice()
+	s.FromRaw(raw)
+	return s
+}
+
+func Test_KVListToMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name: "KV list",
+			value: kvList([]interface{}{
+				map[string]interface{}{"key": "service", "value": "checkout"},
+				map[string]interface{}{"key": "attempt", "value": int64(2)},
+				map[string]interface{}{"key": "http", "value": map[string]interface{}{"status": int64(200)}},
+			}),
+			expected: map[string]interface{}{
+				"service": "checkout",
+				"attempt": int64(2),
+				"http":    map[string]interface{}{"status": int64(200)},
+			},
+		},
+		{
+			name: "duplicate keys",
+			value: kvList([]interface{}{
+				map[string]interface{}{"key": "service", "value": "checkout"},
+				map[string]interface{}{"key": "service", "value": "cart"},
+			}),
+			expected: map[string]interface{}{"service": "cart"},
+		},
+		{
+			name:     "empty list",
+			value:    pcommon.NewSlice(),
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "string target",
+			value:    "service=checkout",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := KVListToMap[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_KVListToMap_malformed(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    []interface{}
+		expected string
+	}{
+		{
+			name:     "element isn't a map",
+			value:    []interface{}{map[string]interface{}{"key": "service", "value": "checkout"}, "attempt"},
+			expected: "the element at index 1 of the KV list isn't a map",
+		},
+		{
+			name:     "missing key",
+			value:    []interface{}{map[string]interface{}{"value": "checkout"}},
+			expected: `the element at index 0 of the KV list doesn't have a string "key"`,
+		},
+		{
+			name:     "key isn't a string",
+			value:    []interface{}{map[string]interface{}{"key": int64(1), "value": "checkout"}},
+			expected: `the element at index 0 of the KV list doesn't have a string "key"`,
+		},
+		{
+			name:     "missing value",
+			value:    []interface{}{map[string]interface{}{"key": "service"}},
+			expected: `the element at index 0 of the KV list doesn't have a "value"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := kvList(tt.value)
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return list, nil
+				},
+			}
+			exprFunc, err := KVListToMap[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_KVListToMap_roundTrip(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("service", "checkout")
+	m.PutInt("attempt", 2)
+	m.PutBool("retry", true)
+	m.PutEmptySlice("tags").AppendEmpty().SetStr("a")
+
+	toList, err := MapToKVList[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return m, nil
+		},
+	})
+	require.NoError(t, err)
+	list, err := toList(nil)
+	require.NoError(t, err)
+
+	toMap, err := KVListToMap[interface{}](&ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return list, nil
+		},
+	})
+	require.NoError(t, err)
+	result, err := toMap(nil)
+	require.NoError(t, err)
+	assert.Equal(t, m.AsRaw(), result.(pcommon.Map).AsRaw())
+}
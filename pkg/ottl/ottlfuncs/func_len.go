@@ -0,0 +1,54 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Len[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		value, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch value := value.(type) {
+		case string:
+			return int64(len(value)), nil
+		case []byte:
+			return int64(len(value)), nil
+		case pcommon.Slice:
+			return int64(value.Len()), nil
+		case pcommon.Map:
+			return int64(value.Len()), nil
+		case []interface{}:
+			return int64(len(value)), nil
+		case []string:
+			return int64(len(value)), nil
+		case []bool:
+			return int64(len(value)), nil
+		case []int64:
+			return int64(len(value)), nil
+		case []float64:
+			return int64(len(value)), nil
+		case map[string]interface{}:
+			return int64(len(value)), nil
+		default:
+			return nil, nil
+		}
+	}, nil
+}
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/contexts/ottllogs"
+)
+
+func Test_Len(t *testing.T) {
+	slice := pcommon.NewSlice()
+	slice.AppendEmpty().SetStr("a")
+	slice.AppendEmpty().SetStr("b")
+
+	m := pcommon.NewMap()
+	m.PutStr("a", "b")
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string",
+			value:    "hello",
+			expected: int64(5),
+		},
+		{
+			name:     "byte slice",
+			value:    []byte{1, 2, 3},
+			expected: int64(3),
+		},
+		{
+			name:     "pcommon.Slice",
+			value:    slice,
+			expected: int64(2),
+		},
+		{
+			name:     "empty pcommon.Slice",
+			value:    pcommon.NewSlice(),
+			expected: int64(0),
+		},
+		{
+			name:     "pcommon.Map",
+			value:    m,
+			expected: int64(1),
+		},
+		{
+			name:     "interface slice",
+			value:    []interface{}{"a", int64(1), true},
+			expected: int64(3),
+		},
+		{
+			name:     "string slice",
+			value:    []string{"a", "b"},
+			expected: int64(2),
+		},
+		{
+			name:     "map",
+			value:    map[string]interface{}{"a": "b", "c": "d"},
+			expected: int64(2),
+		},
+		{
+			name:     "int",
+			value:    int64(5),
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Len[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Len_where(t *testing.T) {
+	parser, err := ottllogs.NewParser(map[string]interface{}{
+		"Len": Len[ottllogs.TransformContext],
+		"set": Set[ottllogs.TransformContext],
+	}, componenttest.NewNopTelemetrySettings())
+	require.NoError(t, err)
+	statements, err := parser.ParseStatements([]string{`set(attributes["too_many_errors"], true) where Len(attributes["errors"]) > 5`})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		errors   int
+		expected bool
+	}{
+		{
+			name:     "empty slice",
+			errors:   0,
+			expected: false,
+		},
+		{
+			name:     "populated slice",
+			errors:   6,
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logRecord := plog.NewLogRecord()
+			errors := logRecord.Attributes().PutEmptySlice("errors")
+			for i := 0; i < tt.errors; i++ {
+				errors.AppendEmpty().SetStr("error")
+			}
+
+			_, _, err := statements[0].Execute(ottllogs.NewTransformContext(logRecord, pcommon.NewInstrumentationScope(), pcommon.NewResource()))
+			require.NoError(t, err)
+
+			_, ok := logRecord.Attributes().Get("too_many_errors")
+			assert.Equal(t, tt.expected, ok)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Lookup[K any](target ottl.Getter[K], keys []string, values []string, defaultValue string) (ottl.ExprFunc[K], error) {
+	if len(keys) != len(values) {
+		return nil, fmt.Errorf("the keys and values supplied to Lookup must have the same length, got %v keys and %v values", len(keys), len(values))
+	}
+	mapping := make(map[string]string, len(keys))
+	for i, key := range keys {
+		if _, ok := mapping[key]; ok {
+			return nil, fmt.Errorf("the key %q is supplied to Lookup more than once", key)
+		}
+		mapping[key] = values[i]
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var key string
+		switch v := val.(type) {
+		case string:
+			key = v
+		case int64:
+			key = strconv.FormatInt(v, 10)
+		case float64:
+			key = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			key = strconv.FormatBool(v)
+		default:
+			return defaultValue, nil
+		}
+		if mapped, ok := mapping[key]; ok {
+			return mapped, nil
+		}
+		return defaultValue, nil
+	}, nil
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Lookup(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string hit",
+			value:    "404",
+			expected: "not found",
+		},
+		{
+			name:     "int hit",
+			value:    int64(200),
+			expected: "ok",
+		},
+		{
+			name:     "float hit",
+			value:    float64(500),
+			expected: "internal error",
+		},
+		{
+			name:     "miss",
+			value:    int64(302),
+			expected: "unknown",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: "unknown",
+		},
+		{
+			name:     "unsupported type",
+			value:    []byte("200"),
+			expected: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Lookup[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, []string{"200", "404", "500"}, []string{"ok", "not found", "internal error"}, "unknown")
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_Lookup_bad_input(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		values   []string
+		expected string
+	}{
+		{
+			name:     "mismatched lengths",
+			keys:     []string{"200", "404"},
+			values:   []string{"ok"},
+			expected: "the keys and values supplied to Lookup must have the same length, got 2 keys and 1 values",
+		},
+		{
+			name:     "duplicate key",
+			keys:     []string{"200", "200"},
+			values:   []string{"ok", "fine"},
+			expected: `the key "200" is supplied to Lookup more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{}
+			exprFunc, err := Lookup[interface{}](target, tt.keys, tt.values, "unknown")
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
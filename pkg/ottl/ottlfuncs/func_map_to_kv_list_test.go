@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_MapToKVList(t *testing.T) {
+	populated := pcommon.NewMap()
+	populated.PutStr("service", "checkout")
+	populated.PutInt("attempt", 2)
+	populated.PutEmptyMap("http").PutInt("status", 200)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:  "populated map",
+			value: populated,
+			expected: []interface{}{
+				map[string]interface{}{"key": "attempt", "value": int64(2)},
+				map[string]interface{}{"key": "http", "value": map[string]interface{}{"status": int64(200)}},
+				map[string]interface{}{"key": "service", "value": "checkout"},
+			},
+		},
+		{
+			name:     "empty map",
+			value:    pcommon.NewMap(),
+			expected: []interface{}{},
+		},
+		{
+			name:     "string target",
+			value:    "service=checkout",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := MapToKVList[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			require.IsType(t, pcommon.Slice{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_MapToKVList_copiesValues(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutEmptyMap("http").PutInt("status", 200)
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return m, nil
+		},
+	}
+	exprFunc, err := MapToKVList[interface{}](target)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	require.NoError(t, err)
+
+	// changing the list doesn't change the map
+	value, _ := result.(pcommon.Slice).At(0).Map().Get("value")
+	value.Map().PutInt("status", 500)
+	http, _ := m.Get("http")
+	assert.Equal(t, map[string]interface{}{"status": int64(200)}, http.Map().AsRaw())
+}
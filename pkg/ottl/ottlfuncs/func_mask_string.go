@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func MaskString[K any](target ottl.Getter[K], visible int64, maskChar string) (ottl.ExprFunc[K], error) {
+	if visible < 0 {
+		return nil, fmt.Errorf("the number of visible characters supplied to MaskString can't be negative, got %d", visible)
+	}
+	if utf8.RuneCountInString(maskChar) != 1 {
+		return nil, fmt.Errorf("the mask character supplied to MaskString must be a single character, got %q", maskChar)
+	}
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := val.(string)
+		if !ok {
+			return nil, nil
+		}
+
+		masked := int64(utf8.RuneCountInString(s)) - visible
+		if masked <= 0 {
+			return s, nil
+		}
+		var sb strings.Builder
+		sb.Grow(int(masked)*len(maskChar) + len(s))
+		for i := range s {
+			if masked == 0 {
+				sb.WriteString(s[i:])
+				break
+			}
+			sb.WriteString(maskChar)
+			masked--
+		}
+		return sb.String(), nil
+	}, nil
+}
@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_MaskString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		visible  int64
+		maskChar string
+		expected interface{}
+	}{
+		{
+			name:     "full masking",
+			value:    "4111111111111111",
+			visible:  0,
+			maskChar: "*",
+			expected: "****************",
+		},
+		{
+			name:     "partial visibility",
+			value:    "4111111111111111",
+			visible:  4,
+			maskChar: "*",
+			expected: "************1111",
+		},
+		{
+			name:     "shorter than visible",
+			value:    "abc",
+			visible:  4,
+			maskChar: "*",
+			expected: "abc",
+		},
+		{
+			name:     "as long as visible",
+			value:    "abcd",
+			visible:  4,
+			maskChar: "*",
+			expected: "abcd",
+		},
+		{
+			name:     "multi-byte runes",
+			value:    "José Müller",
+			visible:  3,
+			maskChar: "•",
+			expected: "••••••••ler",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			visible:  0,
+			maskChar: "*",
+			expected: "",
+		},
+		{
+			name:     "int target",
+			value:    int64(4111),
+			visible:  2,
+			maskChar: "*",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			visible:  2,
+			maskChar: "*",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := MaskString[interface{}](target, tt.visible, tt.maskChar)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_MaskString_invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		visible  int64
+		maskChar string
+		expected string
+	}{
+		{
+			name:     "negative visible",
+			visible:  -1,
+			maskChar: "*",
+			expected: "the number of visible characters supplied to MaskString can't be negative, got -1",
+		},
+		{
+			name:     "empty mask character",
+			visible:  4,
+			maskChar: "",
+			expected: `the mask character supplied to MaskString must be a single character, got ""`,
+		},
+		{
+			name:     "several mask characters",
+			visible:  4,
+			maskChar: "**",
+			expected: `the mask character supplied to MaskString must be a single character, got "**"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{}
+			exprFunc, err := MaskString[interface{}](target, tt.visible, tt.maskChar)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
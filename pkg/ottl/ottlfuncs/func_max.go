@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Max[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return extremeNumber(target, "Max", func(cmp int) bool { return cmp > 0 }), nil
+}
+
+// extremeNumber returns an ExprFunc that returns the element of a numeric slice for which better
+// reports true when it is compared to all other elements.
+func extremeNumber[K any](target ottl.Getter[K], funcName string, better func(cmp int) bool) ottl.ExprFunc[K] {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		numbers, err := sliceNumbers(funcName, val)
+		if err != nil || len(numbers) == 0 {
+			return nil, err
+		}
+		result := numbers[0]
+		for _, n := range numbers[1:] {
+			if better(compareNumbers(n, result)) {
+				result = n
+			}
+		}
+		return result, nil
+	}
+}
+
+// sliceNumbers returns the elements of val as int64 and float64 values if val is a pcommon.Slice,
+// and nil otherwise. An error is returned if the slice has elements that aren't numbers.
+func sliceNumbers(funcName string, val interface{}) ([]interface{}, error) {
+	slice, ok := val.(pcommon.Slice)
+	if !ok {
+		return nil, nil
+	}
+	numbers := make([]interface{}, 0, slice.Len())
+	for i := 0; i < slice.Len(); i++ {
+		elem := slice.At(i)
+		switch elem.Type() {
+		case pcommon.ValueTypeInt:
+			numbers = append(numbers, elem.Int())
+		case pcommon.ValueTypeDouble:
+			numbers = append(numbers, elem.Double())
+		default:
+			return nil, fmt.Errorf("the slice supplied to %v must only contain numbers, got %v at index %v", funcName, elem.Type(), i)
+		}
+	}
+	return numbers, nil
+}
+
+// compareNumbers returns a negative number, 0 or a positive number if a is less than, equal to or
+// greater than b. a and b are int64 or float64 values, and ints are only converted to floats when
+// they are compared to a float.
+func compareNumbers(a, b interface{}) int {
+	aInt, aIsInt := a.(int64)
+	bInt, bIsInt := b.(int64)
+	if aIsInt && bIsInt {
+		switch {
+		case aInt < bInt:
+			return -1
+		case aInt > bInt:
+			return 1
+		default:
+			return 0
+		}
+	}
+	aFloat, _ := toFloat64(a)
+	bFloat, _ := toFloat64(b)
+	switch {
+	case aFloat < bFloat:
+		return -1
+	case aFloat > bFloat:
+		return 1
+	default:
+		return 0
+	}
+}
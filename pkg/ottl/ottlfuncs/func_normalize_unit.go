@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// ucumUnits maps common spellings of units to their UCUM case-sensitive form.
+var ucumUnits = map[string]string{
+	"nanosecond":   "ns",
+	"nanoseconds":  "ns",
+	"nanos":        "ns",
+	"microsecond":  "us",
+	"microseconds": "us",
+	"micros":       "us",
+	"µs":           "us",
+	"millisecond":  "ms",
+	"milliseconds": "ms",
+	"millis":       "ms",
+	"msec":         "ms",
+	"second":       "s",
+	"seconds":      "s",
+	"sec":          "s",
+	"secs":         "s",
+	"minute":       "min",
+	"minutes":      "min",
+	"mins":         "min",
+	"hour":         "h",
+	"hours":        "h",
+	"hr":           "h",
+	"hrs":          "h",
+	"day":          "d",
+	"days":         "d",
+	"bit":          "bit",
+	"bits":         "bit",
+	"byte":         "By",
+	"bytes":        "By",
+	"kilobyte":     "kBy",
+	"kilobytes":    "kBy",
+	"KB":           "kBy",
+	"kB":           "kBy",
+	"KiB":          "KiBy",
+	"megabyte":     "MBy",
+	"megabytes":    "MBy",
+	"MB":           "MBy",
+	"MiB":          "MiBy",
+	"gigabyte":     "GBy",
+	"gigabytes":    "GBy",
+	"GB":           "GBy",
+	"GiB":          "GiBy",
+	"terabyte":     "TBy",
+	"terabytes":    "TBy",
+	"TB":           "TBy",
+	"TiB":          "TiBy",
+	"percent":      "%",
+	"hertz":        "Hz",
+	"celsius":      "Cel",
+}
+
+func NormalizeUnit[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if unit, ok := val.(string); ok {
+			if normalized, ok := ucumUnits[unit]; ok {
+				return normalized, nil
+			}
+			return unit, nil
+		}
+		return nil, nil
+	}, nil
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_NormalizeUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "milliseconds",
+			value:    "milliseconds",
+			expected: "ms",
+		},
+		{
+			name:     "canonical milliseconds",
+			value:    "ms",
+			expected: "ms",
+		},
+		{
+			name:     "bytes",
+			value:    "bytes",
+			expected: "By",
+		},
+		{
+			name:     "canonical bytes",
+			value:    "By",
+			expected: "By",
+		},
+		{
+			name:     "seconds",
+			value:    "seconds",
+			expected: "s",
+		},
+		{
+			name:     "megabytes",
+			value:    "MB",
+			expected: "MBy",
+		},
+		{
+			name:     "percent",
+			value:    "percent",
+			expected: "%",
+		},
+		{
+			name:     "unknown unit",
+			value:    "{requests}",
+			expected: "{requests}",
+		},
+		{
+			name:     "empty unit",
+			value:    "",
+			expected: "",
+		},
+		{
+			name:     "non-string target",
+			value:    int64(1),
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := NormalizeUnit[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func ParseCSV[K any](target ottl.Getter[K], delimiter string) (ottl.ExprFunc[K], error) {
+	comma, size := utf8.DecodeRuneInString(delimiter)
+	if size == 0 || size != len(delimiter) || comma == utf8.RuneError || comma == '"' || comma == '\r' || comma == '\n' {
+		return nil, fmt.Errorf("the delimiter supplied to ParseCSV must be a single character other than a quote or a line break, got %q", delimiter)
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if valStr, ok := val.(string); ok {
+			return parseCSV(valStr, comma)
+		}
+		return nil, nil
+	}, nil
+}
+
+func parseCSV(line string, comma rune) (pcommon.Slice, error) {
+	reader := csv.NewReader(strings.NewReader(line))
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+
+	result := pcommon.NewSlice()
+	fields, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return result, nil
+	}
+	if err != nil {
+		return pcommon.Slice{}, fmt.Errorf("could not parse CSV: %w", err)
+	}
+	result.EnsureCapacity(len(fields))
+	for _, field := range fields {
+		result.AppendEmpty().SetStr(field)
+	}
+	return result, nil
+}
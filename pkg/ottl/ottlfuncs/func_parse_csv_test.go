@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     interface{}
+		delimiter string
+		expected  []interface{}
+	}{
+		{
+			name:      "simple fields",
+			value:     "a,b,c",
+			delimiter: ",",
+			expected:  []interface{}{"a", "b", "c"},
+		},
+		{
+			name:      "quoted field containing the delimiter",
+			value:     `GET,"/api/users?ids=1,2",200`,
+			delimiter: ",",
+			expected:  []interface{}{"GET", "/api/users?ids=1,2", "200"},
+		},
+		{
+			name:      "escaped quotes",
+			value:     `1,"she said ""hi""",3`,
+			delimiter: ",",
+			expected:  []interface{}{"1", `she said "hi"`, "3"},
+		},
+		{
+			name:      "custom delimiter",
+			value:     "a;b,c;d",
+			delimiter: ";",
+			expected:  []interface{}{"a", "b,c", "d"},
+		},
+		{
+			name:      "tab delimiter",
+			value:     "a\tb",
+			delimiter: "\t",
+			expected:  []interface{}{"a", "b"},
+		},
+		{
+			name:      "empty fields",
+			value:     "a,,c,",
+			delimiter: ",",
+			expected:  []interface{}{"a", "", "c", ""},
+		},
+		{
+			name:      "empty string",
+			value:     "",
+			delimiter: ",",
+			expected:  []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ParseCSV[interface{}](target, tt.delimiter)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Slice{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Slice).AsRaw())
+		})
+	}
+}
+
+func Test_ParseCSV_bad_input(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return 123, nil
+		},
+	}
+	exprFunc, err := ParseCSV[interface{}](target, ",")
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_ParseCSV_invalid_quotes(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return `a,"b`, nil
+		},
+	}
+	exprFunc, err := ParseCSV[interface{}](target, ",")
+	require.NoError(t, err)
+	_, err = exprFunc(nil)
+	assert.ErrorContains(t, err, "could not parse CSV:")
+}
+
+func Test_ParseCSV_invalid_delimiter(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "a,b", nil
+		},
+	}
+	for _, delimiter := range []string{"", ",,", "\"", "\n"} {
+		t.Run(delimiter, func(t *testing.T) {
+			exprFunc, err := ParseCSV[interface{}](target, delimiter)
+			assert.EqualError(t, err, "the delimiter supplied to ParseCSV must be a single character other than a quote or a line break, got "+strconv.Quote(delimiter))
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
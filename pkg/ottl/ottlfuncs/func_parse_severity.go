@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// severityNumbers maps the lowercase level strings to the severity numbers of the OpenTelemetry log data model.
+var severityNumbers = map[string]int64{
+	"trace": int64(plog.SeverityNumberTrace),
+	"debug": int64(plog.SeverityNumberDebug),
+	"info":  int64(plog.SeverityNumberInfo),
+	"warn":  int64(plog.SeverityNumberWarn),
+	"error": int64(plog.SeverityNumberError),
+	"fatal": int64(plog.SeverityNumberFatal),
+}
+
+func ParseSeverity[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := val.(string); ok {
+			if number, ok := severityNumbers[strings.ToLower(s)]; ok {
+				return number, nil
+			}
+		}
+		return nil, nil
+	}, nil
+}
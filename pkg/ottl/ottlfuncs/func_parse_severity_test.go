@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseSeverity(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "trace",
+			value:    "trace",
+			expected: int64(1),
+		},
+		{
+			name:     "debug",
+			value:    "debug",
+			expected: int64(5),
+		},
+		{
+			name:     "info",
+			value:    "info",
+			expected: int64(9),
+		},
+		{
+			name:     "warn",
+			value:    "warn",
+			expected: int64(13),
+		},
+		{
+			name:     "error",
+			value:    "error",
+			expected: int64(17),
+		},
+		{
+			name:     "fatal",
+			value:    "fatal",
+			expected: int64(21),
+		},
+		{
+			name:     "uppercase",
+			value:    "ERROR",
+			expected: int64(17),
+		},
+		{
+			name:     "mixed case",
+			value:    "Warn",
+			expected: int64(13),
+		},
+		{
+			name:     "unknown level",
+			value:    "verbose",
+			expected: nil,
+		},
+		{
+			name:     "int target",
+			value:    int64(9),
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ParseSeverity[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func ParseURL[K any](interner *ottl.Interner, target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if val != nil {
+			if valStr, ok := val.(string); ok {
+				return parseURL(valStr, interner)
+			}
+		}
+		return nil, nil
+	}, nil
+}
+
+func parseURL(raw string, interner *ottl.Interner) (pcommon.Map, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return pcommon.Map{}, fmt.Errorf("could not parse URL: %w", err)
+	}
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return pcommon.Map{}, fmt.Errorf("could not parse URL query: %w", err)
+	}
+
+	result := pcommon.NewMap()
+	result.PutStr("scheme", u.Scheme)
+	result.PutStr("host", u.Host)
+	result.PutStr("path", u.Path)
+	queryMap := result.PutEmptyMap("query")
+	for key, values := range query {
+		key = interner.Intern(key)
+		if len(values) == 1 {
+			queryMap.PutStr(key, values[0])
+			continue
+		}
+		s := queryMap.PutEmptySlice(key)
+		for _, v := range values {
+			s.AppendEmpty().SetStr(v)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_parseURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		target ottl.Getter[interface{}]
+		want   func(pcommon.Map)
+	}{
+		{
+			name: "full URL with query",
+			target: ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "https://example.com:8443/api/users?id=42&tag=a&tag=b", nil
+				},
+			},
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("scheme", "https")
+				expectedMap.PutStr("host", "example.com:8443")
+				expectedMap.PutStr("path", "/api/users")
+				query := expectedMap.PutEmptyMap("query")
+				query.PutStr("id", "42")
+				tags := query.PutEmptySlice("tag")
+				tags.AppendEmpty().SetStr("a")
+				tags.AppendEmpty().SetStr("b")
+			},
+		},
+		{
+			name: "path only URL",
+			target: ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return "/health%20check", nil
+				},
+			},
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("scheme", "")
+				expectedMap.PutStr("host", "")
+				expectedMap.PutStr("path", "/health check")
+				expectedMap.PutEmptyMap("query")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ParseURL(nil, tt.target)
+			assert.NoError(t, err)
+
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+
+			resultMap, ok := result.(pcommon.Map)
+			assert.True(t, ok)
+			assert.Equal(t, expected.Sort().AsRaw(), resultMap.Sort().AsRaw())
+		})
+	}
+}
+
+func Test_parseURL_error(t *testing.T) {
+	target := ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "http://[::1", nil
+		},
+	}
+	exprFunc, err := ParseURL[interface{}](nil, target)
+	assert.NoError(t, err)
+
+	_, err = exprFunc(nil)
+	assert.ErrorContains(t, err, "could not parse URL")
+}
+
+func Test_parseURL_non_string(t *testing.T) {
+	target := ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return 1, nil
+		},
+	}
+	exprFunc, err := ParseURL[interface{}](nil, target)
+	assert.NoError(t, err)
+
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
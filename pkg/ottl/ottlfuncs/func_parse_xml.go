@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func ParseXML[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if valStr, ok := val.(string); ok {
+			return parseXML(valStr)
+		}
+		return nil, nil
+	}, nil
+}
+
+// xmlElement is an element of an XML document being parsed.
+type xmlElement struct {
+	name     string
+	attrs    []xml.Attr
+	children []*xmlElement
+	text     strings.Builder
+}
+
+func parseXML(doc string) (pcommon.Map, error) {
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	var root *xmlElement
+	var stack []*xmlElement
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return pcommon.Map{}, fmt.Errorf("could not parse XML: %w", err)
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			element := &xmlElement{name: t.Name.Local, attrs: t.Attr}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, element)
+			} else if root != nil {
+				return pcommon.Map{}, errors.New("could not parse XML: more than one root element")
+			} else {
+				root = element
+			}
+			stack = append(stack, element)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		}
+	}
+	if root == nil {
+		return pcommon.Map{}, errors.New("could not parse XML: no root element")
+	}
+
+	result := pcommon.NewMap()
+	result.FromRaw(map[string]interface{}{root.name: root.raw()})
+	return result, nil
+}
+
+// raw returns the text of an element without attributes nor children, and a map of its attributes,
+// children and text otherwise. Children sharing a name are grouped in a slice.
+func (e *xmlElement) raw() interface{} {
+	text := strings.TrimSpace(e.text.String())
+	if len(e.attrs) == 0 && len(e.children) == 0 {
+		return text
+	}
+	result := make(map[string]interface{}, len(e.attrs)+len(e.children)+1)
+	for _, attr := range e.attrs {
+		// namespace declarations aren't part of the data
+		if attr.Name.Space == "xmlns" || attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			continue
+		}
+		result["@"+attr.Name.Local] = attr.Value
+	}
+	for _, child := range e.children {
+		switch existing := result[child.name].(type) {
+		case nil:
+			result[child.name] = child.raw()
+		case []interface{}:
+			result[child.name] = append(existing, child.raw())
+		default:
+			result[child.name] = []interface{}{existing, child.raw()}
+		}
+	}
+	if text != "" {
+		result["#text"] = text
+	}
+	return result
+}
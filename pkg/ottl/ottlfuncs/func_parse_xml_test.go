@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ParseXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected map[string]interface{}
+	}{
+		{
+			name:     "text element",
+			value:    "<status>ok</status>",
+			expected: map[string]interface{}{"status": "ok"},
+		},
+		{
+			name:     "empty element",
+			value:    "<status/>",
+			expected: map[string]interface{}{"status": ""},
+		},
+		{
+			name: "nested elements",
+			value: `<?xml version="1.0"?>
+<order>
+  <id>42</id>
+  <customer>
+    <name>Jane</name>
+  </customer>
+</order>`,
+			expected: map[string]interface{}{
+				"order": map[string]interface{}{
+					"id": "42",
+					"customer": map[string]interface{}{
+						"name": "Jane",
+					},
+				},
+			},
+		},
+		{
+			name:  "repeated elements",
+			value: "<items><item>a</item><item>b</item><other>c</other><item>d</item></items>",
+			expected: map[string]interface{}{
+				"items": map[string]interface{}{
+					"item":  []interface{}{"a", "b", "d"},
+					"other": "c",
+				},
+			},
+		},
+		{
+			name:  "element attributes",
+			value: `<error code="500" retryable="false">internal error</error>`,
+			expected: map[string]interface{}{
+				"error": map[string]interface{}{
+					"@code":      "500",
+					"@retryable": "false",
+					"#text":      "internal error",
+				},
+			},
+		},
+		{
+			name: "SOAP envelope",
+			value: `<soap:Envelope xmlns:soap="http://www.w3.org/2003/05/soap-envelope" xmlns="urn:example">
+  <soap:Body>
+    <GetPrice currency="EUR"><Item>Apple</Item></GetPrice>
+  </soap:Body>
+</soap:Envelope>`,
+			expected: map[string]interface{}{
+				"Envelope": map[string]interface{}{
+					"Body": map[string]interface{}{
+						"GetPrice": map[string]interface{}{
+							"@currency": "EUR",
+							"Item":      "Apple",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "character data and entities",
+			value: `<query><![CDATA[a < b]]> &amp; c</query>`,
+			expected: map[string]interface{}{
+				"query": "a < b & c",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ParseXML[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			require.NoError(t, err)
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_ParseXML_bad_input(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return 123, nil
+		},
+	}
+	exprFunc, err := ParseXML[interface{}](target)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func Test_ParseXML_malformed(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "unclosed element",
+			value:    "<a><b>text</a>",
+			expected: "could not parse XML:",
+		},
+		{
+			name:     "not XML",
+			value:    "{\"a\": 1}",
+			expected: "could not parse XML: no root element",
+		},
+		{
+			name:     "empty string",
+			value:    "",
+			expected: "could not parse XML: no root element",
+		},
+		{
+			name:     "several root elements",
+			value:    "<a/><b/>",
+			expected: "could not parse XML: more than one root element",
+		},
+		{
+			name:     "truncated document",
+			value:    "<a><b>",
+			expected: "could not parse XML:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := ParseXML[interface{}](target)
+			require.NoError(t, err)
+			_, err = exprFunc(nil)
+			assert.ErrorContains(t, err, tt.expected)
+		})
+	}
+}
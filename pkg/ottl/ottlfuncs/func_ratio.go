@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Ratio[K any](numerator ottl.Getter[K], denominator ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		numVal, err := numerator.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		denVal, err := denominator.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		num, ok := toFloat64(numVal)
+		if !ok {
+			return nil, nil
+		}
+		den, ok := toFloat64(denVal)
+		if !ok || den == 0 {
+			return nil, nil
+		}
+		return num / den, nil
+	}, nil
+}
+
+// toFloat64 converts the numeric values OTTL works with to float64.
+func toFloat64(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
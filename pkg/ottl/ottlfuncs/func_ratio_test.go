@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Ratio(t *testing.T) {
+	tests := []struct {
+		name        string
+		numerator   interface{}
+		denominator interface{}
+		expected    interface{}
+	}{
+		{
+			name:        "int64",
+			numerator:   int64(1),
+			denominator: int64(4),
+			expected:    0.25,
+		},
+		{
+			name:        "float64",
+			numerator:   1.5,
+			denominator: 0.5,
+			expected:    3.0,
+		},
+		{
+			name:        "int64 and float64",
+			numerator:   int64(3),
+			denominator: 1.5,
+			expected:    2.0,
+		},
+		{
+			name:        "zero numerator",
+			numerator:   int64(0),
+			denominator: int64(4),
+			expected:    0.0,
+		},
+		{
+			name:        "zero denominator",
+			numerator:   int64(1),
+			denominator: int64(0),
+			expected:    nil,
+		},
+		{
+			name:        "zero float denominator",
+			numerator:   1.0,
+			denominator: 0.0,
+			expected:    nil,
+		},
+		{
+			name:        "string numerator",
+			numerator:   "1",
+			denominator: int64(4),
+			expected:    nil,
+		},
+		{
+			name:        "string denominator",
+			numerator:   int64(1),
+			denominator: "4",
+			expected:    nil,
+		},
+		{
+			name:        "nil",
+			numerator:   nil,
+			denominator: int64(4),
+			expected:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Ratio[interface{}](
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(interface{}) (interface{}, error) {
+						return tt.numerator, nil
+					},
+				},
+				&ottl.StandardGetSetter[interface{}]{
+					Getter: func(interface{}) (interface{}, error) {
+						return tt.denominator, nil
+					},
+				},
+			)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlregex"
+)
+
+func ReplaceAllKeys[K any](settings component.TelemetrySettings, interner *ottl.Interner, target ottl.GetSetter[K], regexPattern string, replacement string) (ottl.ExprFunc[K], error) {
+	compiledPattern, err := ottlregex.Compile(regexPattern)
+	if err != nil {
+		return nil, fmt.Errorf("the regex pattern supplied to replace_all_keys is not a valid pattern: %w", err)
+	}
+	logger := settings.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attrs, ok := val.(pcommon.Map)
+		if !ok {
+			return nil, nil
+		}
+		updated := pcommon.NewMap()
+		updated.EnsureCapacity(attrs.Len())
+		attrs.Range(func(key string, originalValue pcommon.Value) bool {
+			updatedKey := interner.Intern(compiledPattern.ReplaceAllString(key, replacement))
+			if _, exists := updated.Get(updatedKey); exists {
+				logger.Warn("replace_all_keys renamed multiple keys to the same key, keeping the last value",
+					zap.String("key", updatedKey), zap.String("original_key", key))
+			}
+			originalValue.CopyTo(updated.PutEmpty(updatedKey))
+			return true
+		})
+		err = target.Set(ctx, updated)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}, nil
+}
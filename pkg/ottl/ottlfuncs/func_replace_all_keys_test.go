@@ -0,0 +1,226 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_replaceAllKeys(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("http.method", "GET")
+	input.PutInt("http.status_code", 200)
+	input.PutStr("net.peer.name", "example.com")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			val.(pcommon.Map).CopyTo(ctx)
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name         string
+		pattern      string
+		replacement  string
+		want         func(pcommon.Map)
+		wantWarnings int
+	}{
+		{
+			name:        "rename with group reference",
+			pattern:     `^http\.(.*)$`,
+			replacement: "http_${1}",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("http_method", "GET")
+				expectedMap.PutInt("http_status_code", 200)
+				expectedMap.PutStr("net.peer.name", "example.com")
+			},
+		},
+		{
+			name:        "no matches",
+			pattern:     `^db\.`,
+			replacement: "database.",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("http.method", "GET")
+				expectedMap.PutInt("http.status_code", 200)
+				expectedMap.PutStr("net.peer.name", "example.com")
+			},
+		},
+		{
+			name:        "collision",
+			pattern:     `^(http|net)\..*$`,
+			replacement: "$1",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutInt("http", 200)
+				expectedMap.PutStr("net", "example.com")
+			},
+			wantWarnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			core, obs := observer.New(zapcore.WarnLevel)
+			settings := componenttest.NewNopTelemetrySettings()
+			settings.Logger = zap.New(core)
+
+			exprFunc, err := ReplaceAllKeys[pcommon.Map](settings, nil, target, tt.pattern, tt.replacement)
+			require.NoError(t, err)
+
+			_, err = exprFunc(scenarioMap)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+
+			assert.Equal(t, expected, scenarioMap)
+			assert.Equal(t, tt.wantWarnings, obs.Len())
+		})
+	}
+}
+
+func Test_replaceAllKeys_bad_input(t *testing.T) {
+	input := pcommon.NewValueStr("not a map")
+
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx interface{}, val interface{}) error {
+			t.Errorf("nothing should be set in this scenario")
+			return nil
+		},
+	}
+
+	exprFunc, err := ReplaceAllKeys[interface{}](componenttest.NewNopTelemetrySettings(), nil, target, "regexpattern", "{replacement}")
+	assert.NoError(t, err)
+
+	_, err = exprFunc(input)
+	assert.NoError(t, err)
+
+	assert.Equal(t, pcommon.NewValueStr("not a map"), input)
+}
+
+func Test_replaceAllKeys_invalid_pattern(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			t.Errorf("nothing should be received in this scenario")
+			return nil, nil
+		},
+		Setter: func(ctx interface{}, val interface{}) error {
+			t.Errorf("nothing should be set in this scenario")
+			return nil
+		},
+	}
+
+	exprFunc, err := ReplaceAllKeys[interface{}](componenttest.NewNopTelemetrySettings(), nil, target, "*", "{anything}")
+	assert.ErrorContains(t, err, "the regex pattern supplied to replace_all_keys is not a valid pattern: error parsing regexp:")
+	assert.Nil(t, exprFunc)
+}
+
+func Test_replaceAllKeys_interning(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			val.(pcommon.Map).CopyTo(ctx)
+			return nil
+		},
+	}
+	exprFunc, err := ReplaceAllKeys[pcommon.Map](componenttest.NewNopTelemetrySettings(), ottl.NewInterner(10), target, `\.`, "_")
+	require.NoError(t, err)
+
+	var keys []string
+	for i := 0; i < 2; i++ {
+		m := pcommon.NewMap()
+		m.PutStr("http.method", "GET")
+		_, err = exprFunc(m)
+		require.NoError(t, err)
+		m.Range(func(k string, _ pcommon.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+	}
+
+	require.Len(t, keys, 2)
+	assert.Equal(t, "http_method", keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.Equal(t, (*reflect.StringHeader)(unsafe.Pointer(&keys[0])).Data, (*reflect.StringHeader)(unsafe.Pointer(&keys[1])).Data)
+}
+
+// BenchmarkReplaceAllKeys reports the heap retained by the renamed maps, which shrinks when
+// the repeated keys are interned.
+func BenchmarkReplaceAllKeys(b *testing.B) {
+	for _, bm := range []struct {
+		name     string
+		interner *ottl.Interner
+	}{
+		{name: "without interning"},
+		{name: "with interning", interner: ottl.NewInterner(100)},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			input := pcommon.NewMap()
+			input.PutStr("http.method", "GET")
+			input.PutInt("http.status_code", 200)
+			input.PutStr("net.peer.name", "example.com")
+
+			results := make([]pcommon.Map, 0, b.N)
+			target := &ottl.StandardGetSetter[pcommon.Map]{
+				Getter: func(ctx pcommon.Map) (interface{}, error) {
+					return ctx, nil
+				},
+				Setter: func(ctx pcommon.Map, val interface{}) error {
+					results = append(results, val.(pcommon.Map))
+					return nil
+				},
+			}
+			exprFunc, err := ReplaceAllKeys[pcommon.Map](componenttest.NewNopTelemetrySettings(), bm.interner, target, `\.`, "_")
+			require.NoError(b, err)
+
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = exprFunc(input)
+			}
+			b.StopTimer()
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			b.ReportMetric(float64(int64(after.HeapAlloc)-int64(before.HeapAlloc))/float64(b.N), "retained-B/op")
+			runtime.KeepAlive(results)
+		})
+	}
+}
@@ -43,7 +43,7 @@ func ReplaceAllMatches[K any](target ottl.GetSetter[K], pattern string, replacem
 		updated := pcommon.NewMap()
 		attrs.CopyTo(updated)
 		updated.Range(func(key string, value pcommon.Value) bool {
-			if glob.Match(value.Str()) {
+			if value.Type() == pcommon.ValueTypeStr && glob.Match(value.Str()) {
 				value.SetStr(replacement)
 			}
 			return true
@@ -89,6 +89,41 @@ func Test_replaceAllMatches(t *testing.T) {
 	}
 }
 
+func Test_replaceAllMatches_non_string_values(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("test", "hello")
+	input.PutInt("test2", 1)
+	input.PutBool("test3", true)
+	input.PutDouble("test4", 1.5)
+	input.PutEmptyMap("test5").PutStr("nested", "hello")
+	input.PutEmptySlice("test6").AppendEmpty().SetStr("hello")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			val.(pcommon.Map).CopyTo(ctx)
+			return nil
+		},
+	}
+
+	exprFunc, err := ReplaceAllMatches[pcommon.Map](target, "*", "replaced")
+	assert.NoError(t, err)
+	_, err = exprFunc(input)
+	assert.NoError(t, err)
+
+	// only the string value is replaced, even though every value matches the pattern as a string
+	expected := pcommon.NewMap()
+	expected.PutStr("test", "replaced")
+	expected.PutInt("test2", 1)
+	expected.PutBool("test3", true)
+	expected.PutDouble("test4", 1.5)
+	expected.PutEmptyMap("test5").PutStr("nested", "hello")
+	expected.PutEmptySlice("test6").AppendEmpty().SetStr("hello")
+	assert.Equal(t, expected, input)
+}
+
 func Test_replaceAllMatches_bad_input(t *testing.T) {
 	input := pcommon.NewValueStr("not a map")
 	target := &ottl.StandardGetSetter[interface{}]{
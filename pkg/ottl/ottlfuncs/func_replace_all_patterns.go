@@ -16,11 +16,11 @@ package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-c
 
 import (
 	"fmt"
-	"regexp"
 
 	"go.opentelemetry.io/collector/pdata/pcommon"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/internal/ottlregex"
 )
 
 const (
@@ -29,7 +29,7 @@ const (
 )
 
 func ReplaceAllPatterns[K any](target ottl.GetSetter[K], mode string, regexPattern string, replacement string) (ottl.ExprFunc[K], error) {
-	compiledPattern, err := regexp.Compile(regexPattern)
+	compiledPattern, err := ottlregex.Compile(regexPattern)
 	if err != nil {
 		return nil, fmt.Errorf("the regex pattern supplied to replace_all_patterns is not a valid pattern: %w", err)
 	}
@@ -54,18 +54,18 @@ func ReplaceAllPatterns[K any](target ottl.GetSetter[K], mode string, regexPatte
 		attrs.Range(func(key string, originalValue pcommon.Value) bool {
 			switch mode {
 			case modeValue:
-				if compiledPattern.MatchString(originalValue.Str()) {
+				if originalValue.Type() == pcommon.ValueTypeStr && compiledPattern.MatchString(originalValue.Str()) {
 					updatedString := compiledPattern.ReplaceAllLiteralString(originalValue.Str(), replacement)
 					updated.PutStr(key, updatedString)
 				} else {
-					updated.PutStr(key, originalValue.Str())
+					originalValue.CopyTo(updated.PutEmpty(key))
 				}
 			case modeKey:
 				if compiledPattern.MatchString(key) {
 					updatedKey := compiledPattern.ReplaceAllLiteralString(key, replacement)
-					updated.PutStr(updatedKey, originalValue.Str())
+					originalValue.CopyTo(updated.PutEmpty(updatedKey))
 				} else {
-					updated.PutStr(key, originalValue.Str())
+					originalValue.CopyTo(updated.PutEmpty(key))
 				}
 			}
 			return true
@@ -143,6 +143,71 @@ func Test_replaceAllPatterns(t *testing.T) {
 	}
 }
 
+func Test_replaceAllPatterns_non_string_values(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("test", "hello 1")
+	input.PutInt("test2", 1)
+	input.PutBool("test3", true)
+	input.PutEmptyMap("test4").PutStr("nested", "hello 1")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			val.(pcommon.Map).CopyTo(ctx)
+			return nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		mode    string
+		pattern string
+		want    func(pcommon.Map)
+	}{
+		{
+			name:    "values",
+			mode:    modeValue,
+			pattern: `1|true`,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello *")
+				expectedMap.PutInt("test2", 1)
+				expectedMap.PutBool("test3", true)
+				expectedMap.PutEmptyMap("test4").PutStr("nested", "hello 1")
+			},
+		},
+		{
+			name:    "keys",
+			mode:    modeKey,
+			pattern: `2`,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello 1")
+				expectedMap.PutInt("test*", 1)
+				expectedMap.PutBool("test3", true)
+				expectedMap.PutEmptyMap("test4").PutStr("nested", "hello 1")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			exprFunc, err := ReplaceAllPatterns[pcommon.Map](target, tt.mode, tt.pattern, "*")
+			assert.NoError(t, err)
+
+			_, err = exprFunc(scenarioMap)
+			assert.Nil(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+
+			assert.Equal(t, expected, scenarioMap)
+		})
+	}
+}
+
 func Test_replaceAllPatterns_bad_input(t *testing.T) {
 	input := pcommon.NewValueStr("not a map")
 
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func SetAll[K any](targets []ottl.GetSetter[K], values []ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	if len(targets) != len(values) {
+		return nil, fmt.Errorf("the number of targets and values supplied to set_all must match, got %d and %d", len(targets), len(values))
+	}
+	return func(ctx K) (interface{}, error) {
+		// All the values are evaluated before any target is set, so a failure leaves the record
+		// unchanged and a value can't observe the targets set before it.
+		vals := make([]interface{}, len(values))
+		for i, value := range values {
+			val, err := value.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			vals[i] = snapshot(val)
+		}
+		previous := make([]interface{}, len(targets))
+		for i, target := range targets {
+			val, err := target.Get(ctx)
+			if err != nil {
+				return nil, err
+			}
+			// Paths can't be deleted, so a target that doesn't exist couldn't be restored if
+			// setting a later target failed.
+			if val == nil && vals[i] != nil {
+				return nil, fmt.Errorf("the target %d of set_all doesn't exist, only existing targets can be set atomically", i)
+			}
+			previous[i] = snapshot(val)
+		}
+
+		for i, target := range targets {
+			// No fields currently support `null` as a valid type.
+			if vals[i] == nil {
+				continue
+			}
+			if err := target.Set(ctx, vals[i]); err != nil {
+				return nil, multierr.Append(fmt.Errorf("could not set target %d: %w", i, err), restore(ctx, targets[:i], previous[:i]))
+			}
+		}
+		return nil, nil
+	}, nil
+}
+
+// restore sets the targets back to their previous values, in reverse order.
+func restore[K any](ctx K, targets []ottl.GetSetter[K], previous []interface{}) error {
+	var errs error
+	for i := len(targets) - 1; i >= 0; i-- {
+		if previous[i] == nil {
+			// the target was left untouched
+			continue
+		}
+		if err := targets[i].Set(ctx, previous[i]); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("could not restore target %d: %w", i, err))
+		}
+	}
+	return errs
+}
+
+// snapshot returns a copy of the mutable values, which could otherwise be changed by setting
+// another target.
+func snapshot(val interface{}) interface{} {
+	switch v := val.(type) {
+	case pcommon.Map:
+		m := pcommon.NewMap()
+		v.CopyTo(m)
+		return m
+	case pcommon.Slice:
+		s := pcommon.NewSlice()
+		v.CopyTo(s)
+		return s
+	case pcommon.Value:
+		value := pcommon.NewValueEmpty()
+		v.CopyTo(value)
+		return value
+	case []byte:
+		return append([]byte(nil), v...)
+	default:
+		return val
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_setAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []ottl.GetSetter[pcommon.Map]
+		values  []ottl.Getter[pcommon.Map]
+		want    map[string]interface{}
+	}{
+		{
+			name:    "set all targets",
+			targets: []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("b"), setAllKey("nested")},
+			values:  []ottl.Getter[pcommon.Map]{setAllLiteral("x"), setAllLiteral(int64(2)), setAllLiteral("y")},
+			want:    map[string]interface{}{"a": "x", "b": int64(2), "nested": "y"},
+		},
+		{
+			name:    "absent target with a nil value",
+			targets: []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("absent")},
+			values:  []ottl.Getter[pcommon.Map]{setAllLiteral("x"), setAllLiteral(nil)},
+			want:    map[string]interface{}{"a": "x", "b": int64(1), "nested": map[string]interface{}{"c": "d"}},
+		},
+		{
+			name:    "values are evaluated before any target is set",
+			targets: []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("b")},
+			values:  []ottl.Getter[pcommon.Map]{setAllKey("b"), setAllKey("a")},
+			want:    map[string]interface{}{"a": int64(1), "b": "1", "nested": map[string]interface{}{"c": "d"}},
+		},
+		{
+			name:    "maps are copied",
+			targets: []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("nested")},
+			values:  []ottl.Getter[pcommon.Map]{setAllKey("nested"), setAllLiteral("e")},
+			want:    map[string]interface{}{"a": map[string]interface{}{"c": "d"}, "b": int64(1), "nested": "e"},
+		},
+		{
+			name:    "nil values are ignored",
+			targets: []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("b")},
+			values:  []ottl.Getter[pcommon.Map]{setAllLiteral(nil), setAllLiteral("x")},
+			want:    map[string]interface{}{"a": "1", "b": "x", "nested": map[string]interface{}{"c": "d"}},
+		},
+		{
+			name: "no targets",
+			want: map[string]interface{}{"a": "1", "b": int64(1), "nested": map[string]interface{}{"c": "d"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := setAllRecord()
+
+			exprFunc, err := SetAll(tt.targets, tt.values)
+			require.NoError(t, err)
+			result, err := exprFunc(record)
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.want, record.AsRaw())
+		})
+	}
+}
+
+func Test_setAll_aborted(t *testing.T) {
+	failing := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return "read-only", nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			return errors.New("read-only field")
+		},
+	}
+	failingGetter := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return nil, errors.New("missing field")
+		},
+	}
+
+	tests := []struct {
+		name     string
+		targets  []ottl.GetSetter[pcommon.Map]
+		values   []ottl.Getter[pcommon.Map]
+		expected string
+	}{
+		{
+			name:     "failing setter",
+			targets:  []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("nested"), failing, setAllKey("b")},
+			values:   []ottl.Getter[pcommon.Map]{setAllLiteral("x"), setAllLiteral("y"), setAllLiteral("z"), setAllLiteral("w")},
+			expected: "could not set target 2: read-only field",
+		},
+		{
+			name:     "absent target",
+			targets:  []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("absent"), failing},
+			values:   []ottl.Getter[pcommon.Map]{setAllLiteral("x"), setAllLiteral("y"), setAllLiteral("z")},
+			expected: "the target 1 of set_all doesn't exist, only existing targets can be set atomically",
+		},
+		{
+			name:     "failing value",
+			targets:  []ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("b")},
+			values:   []ottl.Getter[pcommon.Map]{setAllLiteral("x"), failingGetter},
+			expected: "missing field",
+		},
+		{
+			name:     "failing target",
+			targets:  []ottl.GetSetter[pcommon.Map]{setAllKey("a"), failingGetter},
+			values:   []ottl.Getter[pcommon.Map]{setAllLiteral("x"), setAllLiteral("y")},
+			expected: "missing field",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := setAllRecord()
+
+			exprFunc, err := SetAll(tt.targets, tt.values)
+			require.NoError(t, err)
+			result, err := exprFunc(record)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, result)
+			// the record is left unchanged
+			assert.Equal(t, setAllRecord().AsRaw(), record.AsRaw())
+		})
+	}
+}
+
+func Test_setAll_mismatching_lengths(t *testing.T) {
+	exprFunc, err := SetAll([]ottl.GetSetter[pcommon.Map]{setAllKey("a"), setAllKey("b")}, []ottl.Getter[pcommon.Map]{setAllLiteral("x")})
+	assert.EqualError(t, err, "the number of targets and values supplied to set_all must match, got 2 and 1")
+	assert.Nil(t, exprFunc)
+}
+
+func setAllRecord() pcommon.Map {
+	record := pcommon.NewMap()
+	record.FromRaw(map[string]interface{}{
+		"a":      "1",
+		"b":      int64(1),
+		"nested": map[string]interface{}{"c": "d"},
+	})
+	return record
+}
+
+// setAllKey gets and sets a key of the record.
+func setAllKey(key string) *ottl.StandardGetSetter[pcommon.Map] {
+	return &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			if val, ok := ctx.Get(key); ok {
+				return ottl.GetValue(val), nil
+			}
+			return nil, nil
+		},
+		Setter: func(ctx pcommon.Map, val interface{}) error {
+			switch v := val.(type) {
+			case string:
+				ctx.PutStr(key, v)
+			case int64:
+				ctx.PutInt(key, v)
+			case pcommon.Map:
+				v.CopyTo(ctx.PutEmptyMap(key))
+			}
+			return nil
+		},
+	}
+}
+
+func setAllLiteral(val interface{}) *ottl.StandardGetSetter[pcommon.Map] {
+	return &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return val, nil
+		},
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_setIfNil(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		value    interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:  "target is nil",
+			key:   "missing",
+			value: "default",
+			expected: map[string]interface{}{
+				"existing": "original",
+				"missing":  "default",
+			},
+		},
+		{
+			name:  "target is not nil",
+			key:   "existing",
+			value: "default",
+			expected: map[string]interface{}{
+				"existing": "original",
+			},
+		},
+		{
+			name:  "value is nil",
+			key:   "missing",
+			value: nil,
+			expected: map[string]interface{}{
+				"existing": "original",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			scenarioMap.PutStr("existing", "original")
+
+			target := &ottl.StandardGetSetter[pcommon.Map]{
+				Getter: func(ctx pcommon.Map) (interface{}, error) {
+					if v, ok := ctx.Get(tt.key); ok {
+						return v.Str(), nil
+					}
+					return nil, nil
+				},
+				Setter: func(ctx pcommon.Map, val interface{}) error {
+					ctx.PutStr(tt.key, val.(string))
+					return nil
+				},
+			}
+			value := &ottl.StandardGetSetter[pcommon.Map]{
+				Getter: func(ctx pcommon.Map) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+
+			exprFunc, err := SetIfNil[pcommon.Map](target, value)
+			require.NoError(t, err)
+
+			result, err := exprFunc(scenarioMap)
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expected, scenarioMap.AsRaw())
+		})
+	}
+}
+
+func Test_setIfNil_not_nil(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "existing", nil
+		},
+		Setter: func(ctx interface{}, val interface{}) error {
+			t.Errorf("nothing should be set in this scenario")
+			return nil
+		},
+	}
+	value := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			t.Errorf("value shouldn't be evaluated in this scenario")
+			return "default", nil
+		},
+	}
+
+	exprFunc, err := SetIfNil[interface{}](target, value)
+	require.NoError(t, err)
+
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
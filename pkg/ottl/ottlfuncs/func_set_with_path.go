@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func SetWithPath[K any](target ottl.Getter[K], path []string, value ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("the path supplied to set_with_path must not be empty")
+	}
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		attrs, ok := val.(pcommon.Map)
+		if !ok {
+			return nil, nil
+		}
+
+		newVal, err := value.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		// No fields currently support `null` as a valid type.
+		if newVal == nil {
+			return nil, nil
+		}
+
+		for i, key := range path[:len(path)-1] {
+			intermediate, ok := attrs.Get(key)
+			if !ok {
+				attrs = attrs.PutEmptyMap(key)
+				continue
+			}
+			if intermediate.Type() != pcommon.ValueTypeMap {
+				return nil, fmt.Errorf("cannot set path %q: %q is a %s, not a map", path, path[:i+1], intermediate.Type())
+			}
+			attrs = intermediate.Map()
+		}
+
+		return nil, ottl.SetValue(attrs.PutEmpty(path[len(path)-1]), newVal)
+	}, nil
+}
@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_setWithPath(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("test", "hello world")
+	input.PutEmptyMap("a").PutStr("existing", "value")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+
+	tests := []struct {
+		name  string
+		path  []string
+		value interface{}
+		want  func(pcommon.Map)
+	}{
+		{
+			name:  "create two levels from scratch",
+			path:  []string{"x", "y"},
+			value: "new",
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello world")
+				expectedMap.PutEmptyMap("a").PutStr("existing", "value")
+				expectedMap.PutEmptyMap("x").PutStr("y", "new")
+			},
+		},
+		{
+			name:  "set into existing map",
+			path:  []string{"a", "b"},
+			value: int64(1),
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello world")
+				a := expectedMap.PutEmptyMap("a")
+				a.PutStr("existing", "value")
+				a.PutInt("b", 1)
+			},
+		},
+		{
+			name:  "single key",
+			path:  []string{"test"},
+			value: true,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutBool("test", true)
+				expectedMap.PutEmptyMap("a").PutStr("existing", "value")
+			},
+		},
+		{
+			name:  "nil value",
+			path:  []string{"x", "y"},
+			value: nil,
+			want: func(expectedMap pcommon.Map) {
+				expectedMap.PutStr("test", "hello world")
+				expectedMap.PutEmptyMap("a").PutStr("existing", "value")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scenarioMap := pcommon.NewMap()
+			input.CopyTo(scenarioMap)
+
+			value := &ottl.StandardGetSetter[pcommon.Map]{
+				Getter: func(ctx pcommon.Map) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+
+			exprFunc, err := SetWithPath[pcommon.Map](target, tt.path, value)
+			assert.NoError(t, err)
+
+			_, err = exprFunc(scenarioMap)
+			assert.NoError(t, err)
+
+			expected := pcommon.NewMap()
+			tt.want(expected)
+
+			assert.Equal(t, expected, scenarioMap)
+		})
+	}
+}
+
+func Test_setWithPath_nonMapIntermediate(t *testing.T) {
+	input := pcommon.NewMap()
+	input.PutStr("test", "hello world")
+
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+	value := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return "new", nil
+		},
+	}
+
+	exprFunc, err := SetWithPath[pcommon.Map](target, []string{"test", "b"}, value)
+	assert.NoError(t, err)
+
+	_, err = exprFunc(input)
+	assert.EqualError(t, err, `cannot set path ["test" "b"]: ["test"] is a Str, not a map`)
+	v, _ := input.Get("test")
+	assert.Equal(t, "hello world", v.Str())
+}
+
+func Test_setWithPath_emptyPath(t *testing.T) {
+	target := &ottl.StandardGetSetter[pcommon.Map]{
+		Getter: func(ctx pcommon.Map) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+	_, err := SetWithPath[pcommon.Map](target, []string{}, target)
+	assert.EqualError(t, err, "the path supplied to set_with_path must not be empty")
+}
+
+func Test_setWithPath_bad_input(t *testing.T) {
+	input := pcommon.NewValueStr("not a map")
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+	}
+	value := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "new", nil
+		},
+	}
+
+	exprFunc, err := SetWithPath[interface{}](target, []string{"a", "b"}, value)
+	assert.NoError(t, err)
+	result, err := exprFunc(input)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, pcommon.NewValueStr("not a map"), input)
+}
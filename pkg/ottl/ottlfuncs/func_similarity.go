@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// similarityMaxLength is the number of characters of each string compared by Similarity. Longer
+// strings are truncated, as the cost of the comparison grows with the product of the lengths.
+const similarityMaxLength = 1024
+
+func Similarity[K any](a ottl.Getter[K], b ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		aVal, err := a.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bVal, err := b.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		aStr, aOk := aVal.(string)
+		bStr, bOk := bVal.(string)
+		if !aOk || !bOk {
+			return nil, nil
+		}
+
+		aRunes, bRunes := truncateRunes(aStr), truncateRunes(bStr)
+		longest := len(aRunes)
+		if len(bRunes) > longest {
+			longest = len(bRunes)
+		}
+		if longest == 0 {
+			return 1.0, nil
+		}
+		return 1 - float64(levenshtein(aRunes, bRunes))/float64(longest), nil
+	}, nil
+}
+
+func truncateRunes(s string) []rune {
+	runes := []rune(s)
+	if len(runes) > similarityMaxLength {
+		return runes[:similarityMaxLength]
+	}
+	return runes
+}
+
+// levenshtein returns the minimum number of single character insertions, deletions and
+// substitutions needed to change a into b.
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			// substitution, or no change if the characters are equal
+			current[j] = previous[j-1]
+			if a[i-1] != b[j-1] {
+				current[j]++
+			}
+			// deletion and insertion
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
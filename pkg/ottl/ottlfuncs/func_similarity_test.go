@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Similarity(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        interface{}
+		b        interface{}
+		expected interface{}
+	}{
+		{
+			name:     "identical strings",
+			a:        "connection refused",
+			b:        "connection refused",
+			expected: 1.0,
+		},
+		{
+			name:     "completely different strings",
+			a:        "abc",
+			b:        "xyz",
+			expected: 0.0,
+		},
+		{
+			name:     "partially similar strings",
+			a:        "kitten",
+			b:        "sitting",
+			expected: 1 - 3.0/7,
+		},
+		{
+			name:     "similar log lines",
+			a:        "user 1234 logged in",
+			b:        "user 5678 logged in",
+			expected: 1 - 4.0/19,
+		},
+		{
+			name:     "one empty string",
+			a:        "",
+			b:        "abcd",
+			expected: 0.0,
+		},
+		{
+			name:     "both empty strings",
+			a:        "",
+			b:        "",
+			expected: 1.0,
+		},
+		{
+			name:     "multi-byte runes",
+			a:        "café",
+			b:        "cafe",
+			expected: 0.75,
+		},
+		{
+			name:     "int operand",
+			a:        "1",
+			b:        int64(1),
+			expected: nil,
+		},
+		{
+			name:     "nil operand",
+			a:        nil,
+			b:        "abc",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.a, nil
+				},
+			}
+			b := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.b, nil
+				},
+			}
+			exprFunc, err := Similarity[interface{}](a, b)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			if expected, ok := tt.expected.(float64); ok {
+				require.IsType(t, float64(0), result)
+				assert.InDelta(t, expected, result.(float64), 1e-9)
+				return
+			}
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func Test_Similarity_truncated(t *testing.T) {
+	// the strings only differ after the maximum length
+	prefix := strings.Repeat("a", similarityMaxLength)
+	a := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return prefix + "bbbb", nil
+		},
+	}
+	b := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return prefix + strings.Repeat("c", 10*similarityMaxLength), nil
+		},
+	}
+	exprFunc, err := Similarity[interface{}](a, b)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 1.0, result)
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func SortSlice[K any](target ottl.GetSetter[K], order string) (ottl.ExprFunc[K], error) {
+	var descending bool
+	switch order {
+	case "asc":
+	case "desc":
+		descending = true
+	default:
+		return nil, fmt.Errorf(`the order supplied to sort_slice must be "asc" or "desc", got %q`, order)
+	}
+
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		slice, ok := val.(pcommon.Slice)
+		if !ok {
+			return nil, nil
+		}
+
+		indexes := make([]int, slice.Len())
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.SliceStable(indexes, func(i, j int) bool {
+			c := compareSliceElements(slice.At(indexes[i]), slice.At(indexes[j]))
+			if descending {
+				return c > 0
+			}
+			return c < 0
+		})
+
+		sorted := pcommon.NewSlice()
+		sorted.EnsureCapacity(slice.Len())
+		for _, i := range indexes {
+			slice.At(i).CopyTo(sorted.AppendEmpty())
+		}
+		return nil, target.Set(ctx, sorted)
+	}, nil
+}
+
+// sortRank orders the types of slice elements: booleans, then numbers, then strings, then bytes,
+// then every other type.
+func sortRank(v pcommon.Value) int {
+	switch v.Type() {
+	case pcommon.ValueTypeBool:
+		return 0
+	case pcommon.ValueTypeInt, pcommon.ValueTypeDouble:
+		return 1
+	case pcommon.ValueTypeStr:
+		return 2
+	case pcommon.ValueTypeBytes:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// compareSliceElements orders a and b by type, then by value. Elements that are neither booleans,
+// numbers, strings nor bytes are considered equal to each other.
+func compareSliceElements(a, b pcommon.Value) int {
+	aRank, bRank := sortRank(a), sortRank(b)
+	if aRank != bRank {
+		return aRank - bRank
+	}
+	switch aRank {
+	case 0:
+		switch {
+		case a.Bool() == b.Bool():
+			return 0
+		case b.Bool():
+			return -1
+		default:
+			return 1
+		}
+	case 1:
+		return compareNumbers(ottl.GetValue(a), ottl.GetValue(b))
+	case 2:
+		return strings.Compare(a.Str(), b.Str())
+	case 3:
+		return bytes.Compare(a.Bytes().AsRaw(), b.Bytes().AsRaw())
+	default:
+		return 0
+	}
+}
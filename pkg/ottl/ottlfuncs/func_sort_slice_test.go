@@ -0,0 +1,126 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_sortSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    pcommon.Slice
+		order    string
+		expected []interface{}
+	}{
+		{
+			name:     "strings",
+			input:    sliceOf("b", "c", "a"),
+			order:    "asc",
+			expected: []interface{}{"a", "b", "c"},
+		},
+		{
+			name:     "numbers",
+			input:    sliceOf(int64(3), 1.5, int64(-2), 2.0),
+			order:    "asc",
+			expected: []interface{}{int64(-2), 1.5, 2.0, int64(3)},
+		},
+		{
+			name:     "descending",
+			input:    sliceOf("b", "c", "a"),
+			order:    "desc",
+			expected: []interface{}{"c", "b", "a"},
+		},
+		{
+			name:     "mixed types",
+			input:    sliceOf("a", int64(1), []byte{1}, true, 0.5, false),
+			order:    "asc",
+			expected: []interface{}{false, true, 0.5, int64(1), "a", []byte{1}},
+		},
+		{
+			name:     "mixed types descending",
+			input:    sliceOf("a", int64(1), []byte{1}, true, 0.5, false),
+			order:    "desc",
+			expected: []interface{}{[]byte{1}, "a", int64(1), 0.5, true, false},
+		},
+		{
+			name:     "equal numbers keep their order",
+			input:    sliceOf(2.0, int64(1), int64(2)),
+			order:    "asc",
+			expected: []interface{}{int64(1), 2.0, int64(2)},
+		},
+		{
+			name:     "empty slice",
+			input:    pcommon.NewSlice(),
+			order:    "asc",
+			expected: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[pcommon.Slice]{
+				Getter: func(ctx pcommon.Slice) (interface{}, error) {
+					return ctx, nil
+				},
+				Setter: func(ctx pcommon.Slice, val interface{}) error {
+					val.(pcommon.Slice).CopyTo(ctx)
+					return nil
+				},
+			}
+
+			exprFunc, err := SortSlice[pcommon.Slice](target, tt.order)
+			require.NoError(t, err)
+
+			result, err := exprFunc(tt.input)
+			assert.NoError(t, err)
+			assert.Nil(t, result)
+			assert.Equal(t, tt.expected, tt.input.AsRaw())
+		})
+	}
+}
+
+func Test_sortSlice_bad_input(t *testing.T) {
+	input := pcommon.NewValueStr("not a slice")
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return ctx, nil
+		},
+		Setter: func(ctx interface{}, val interface{}) error {
+			t.Errorf("nothing should be set in this scenario")
+			return nil
+		},
+	}
+
+	exprFunc, err := SortSlice[interface{}](target, "asc")
+	require.NoError(t, err)
+
+	result, err := exprFunc(input)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, pcommon.NewValueStr("not a slice"), input)
+}
+
+func Test_sortSlice_invalid_order(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{}
+	exprFunc, err := SortSlice[interface{}](target, "ascending")
+	assert.EqualError(t, err, `the order supplied to sort_slice must be "asc" or "desc", got "ascending"`)
+	assert.Nil(t, exprFunc)
+}
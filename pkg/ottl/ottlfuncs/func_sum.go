@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Sum[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := val.(pcommon.Slice); !ok {
+			return nil, nil
+		}
+		numbers, err := sliceNumbers("Sum", val)
+		if err != nil {
+			return nil, err
+		}
+		return sumNumbers(numbers), nil
+	}, nil
+}
+
+// sumNumbers returns the sum of numbers as an int64 if all of them are int64 values, and as a
+// float64 otherwise.
+func sumNumbers(numbers []interface{}) interface{} {
+	var intSum int64
+	var floatSum float64
+	allInts := true
+	for _, n := range numbers {
+		switch n := n.(type) {
+		case int64:
+			intSum += n
+		case float64:
+			floatSum += n
+			allInts = false
+		}
+	}
+	if allInts {
+		return intSum
+	}
+	return float64(intSum) + floatSum
+}
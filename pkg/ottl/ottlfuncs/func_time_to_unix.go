@@ -0,0 +1,48 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TimeToUnix[K any](target ottl.Getter[K], unit string) (ottl.ExprFunc[K], error) {
+	d, err := unixUnit("TimeToUnix", unit)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		t, ok := val.(time.Time)
+		if !ok {
+			return nil, nil
+		}
+		switch d {
+		case time.Second:
+			return t.Unix(), nil
+		case time.Millisecond:
+			return t.UnixMilli(), nil
+		case time.Microsecond:
+			return t.UnixMicro(), nil
+		default:
+			return t.UnixNano(), nil
+		}
+	}, nil
+}
@@ -0,0 +1,123 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TimeToUnix(t *testing.T) {
+	tm := time.Date(2023, 11, 14, 22, 13, 19, 123456789, time.UTC)
+	tests := []struct {
+		name     string
+		value    interface{}
+		unit     string
+		expected interface{}
+	}{
+		{
+			name:     "seconds",
+			value:    tm,
+			unit:     "s",
+			expected: int64(1699999999),
+		},
+		{
+			name:     "milliseconds",
+			value:    tm,
+			unit:     "ms",
+			expected: int64(1699999999123),
+		},
+		{
+			name:     "microseconds",
+			value:    tm,
+			unit:     "us",
+			expected: int64(1699999999123456),
+		},
+		{
+			name:     "nanoseconds",
+			value:    tm,
+			unit:     "ns",
+			expected: int64(1699999999123456789),
+		},
+		{
+			name:     "time in another location",
+			value:    tm.In(time.FixedZone("UTC+2", 2*60*60)),
+			unit:     "s",
+			expected: int64(1699999999),
+		},
+		{
+			name:     "int target",
+			value:    int64(1699999999),
+			unit:     "s",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			unit:     "s",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := TimeToUnix[interface{}](target, tt.unit)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_TimeToUnix_round_trip(t *testing.T) {
+	for _, unit := range []string{"s", "ms", "us", "ns"} {
+		t.Run(unit, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return int64(1699999999), nil
+				},
+			}
+			toTime, err := UnixToTime[interface{}](target, unit)
+			require.NoError(t, err)
+			toUnix, err := TimeToUnix[interface{}](&ottl.StandardGetSetter[interface{}]{Getter: toTime}, unit)
+			require.NoError(t, err)
+
+			result, err := toUnix(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1699999999), result)
+		})
+	}
+}
+
+func Test_TimeToUnix_invalid_unit(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return time.Now(), nil
+		},
+	}
+	exprFunc, err := TimeToUnix[interface{}](target, "seconds")
+	assert.EqualError(t, err, `the unit supplied to TimeToUnix must be one of "s", "ms", "us" or "ns", got "seconds"`)
+	assert.Nil(t, exprFunc)
+}
@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_ToJSON(t *testing.T) {
+	nested := pcommon.NewMap()
+	nested.PutStr("string", "hello")
+	nested.PutInt("int", 1)
+	nested.PutDouble("double", 1.5)
+	nested.PutBool("bool", true)
+	inner := nested.PutEmptyMap("map")
+	inner.PutStr("key", "value")
+	innerSlice := inner.PutEmptySlice("slice")
+	innerSlice.AppendEmpty().SetInt(2)
+	innerSlice.AppendEmpty().SetStr("b")
+
+	slice := pcommon.NewSlice()
+	slice.AppendEmpty().SetStr("a")
+	slice.AppendEmpty().SetInt(1)
+	slice.AppendEmpty().SetEmptyMap().PutBool("ok", false)
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "nested map",
+			value:    nested,
+			expected: `{"bool":true,"double":1.5,"int":1,"map":{"key":"value","slice":[2,"b"]},"string":"hello"}`,
+		},
+		{
+			name:     "slice",
+			value:    slice,
+			expected: `["a",1,{"ok":false}]`,
+		},
+		{
+			name:     "empty map",
+			value:    pcommon.NewMap(),
+			expected: `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ToJSON[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_ToJSON_bad_input(t *testing.T) {
+	nan := pcommon.NewMap()
+	nan.PutDouble("nan", math.NaN())
+
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{
+			name:     "string",
+			value:    "hello",
+			expected: "the target of ToJSON must be a map or a slice, got string",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: "the target of ToJSON must be a map or a slice, got <nil>",
+		},
+		{
+			name:     "unsupported value",
+			value:    nan,
+			expected: "could not convert the target of ToJSON to JSON: json: unsupported value: NaN",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := ToJSON[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			})
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.EqualError(t, err, tt.expected)
+			assert.Nil(t, result)
+		})
+	}
+}
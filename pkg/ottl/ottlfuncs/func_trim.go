@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Trim[K any](target ottl.Getter[K], cutset ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	if cutset.IsEmpty() || cutset.Get() == "" {
+		return trim(target, strings.TrimSpace), nil
+	}
+	return trim(target, func(s string) string {
+		return strings.Trim(s, cutset.Get())
+	}), nil
+}
+
+// trim builds an ExprFunc that applies f to the target if it's a string, and returns nil otherwise.
+func trim[K any](target ottl.Getter[K], f func(string) string) ottl.ExprFunc[K] {
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if valStr, ok := val.(string); ok {
+			return f(valStr), nil
+		}
+		return nil, nil
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TrimLeft[K any](target ottl.Getter[K], cutset ottl.Optional[string]) (ottl.ExprFunc[K], error) {
+	if cutset.IsEmpty() || cutset.Get() == "" {
+		return trim(target, func(s string) string {
+			return strings.TrimLeftFunc(s, unicode.IsSpace)
+		}), nil
+	}
+	return trim(target, func(s string) string {
+		return strings.TrimLeft(s, cutset.Get())
+	}), nil
+}
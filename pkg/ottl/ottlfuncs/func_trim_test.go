@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_Trim(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		cutset   ottl.Optional[string]
+		expected interface{}
+	}{
+		{
+			name:     "default whitespace",
+			value:    " \t hello world\n ",
+			expected: "hello world",
+		},
+		{
+			name:     "empty cutset",
+			value:    " \t hello world\n ",
+			cutset:   ottl.NewTestingOptional(""),
+			expected: "hello world",
+		},
+		{
+			name:     "unicode whitespace",
+			value:    " hello ",
+			expected: "hello",
+		},
+		{
+			name:     "custom cutset",
+			value:    "--==hello==--",
+			cutset:   ottl.NewTestingOptional("-="),
+			expected: "hello",
+		},
+		{
+			name:     "custom cutset keeps whitespace",
+			value:    " -hello- ",
+			cutset:   ottl.NewTestingOptional("-"),
+			expected: " -hello- ",
+		},
+		{
+			name:     "nothing to trim",
+			value:    "hello",
+			expected: "hello",
+		},
+		{
+			name:     "non-string",
+			value:    int64(1),
+			expected: nil,
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exprFunc, err := Trim[interface{}](&ottl.StandardGetSetter[interface{}]{
+				Getter: func(interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}, tt.cutset)
+			assert.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func TypeOf[K any](target ottl.Getter[K]) (ottl.ExprFunc[K], error) {
+	return func(ctx K) (interface{}, error) {
+		value, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch value := value.(type) {
+		case nil:
+			return "Empty", nil
+		case string:
+			return "String", nil
+		case int64:
+			return "Int", nil
+		case float64:
+			return "Double", nil
+		case bool:
+			return "Bool", nil
+		case []byte, pcommon.TraceID, pcommon.SpanID:
+			return "Bytes", nil
+		case pcommon.Map, map[string]interface{}:
+			return "Map", nil
+		case pcommon.Slice, []interface{}, []string, []bool, []int64, []float64:
+			return "Slice", nil
+		case pcommon.Value:
+			return valueTypeName(value.Type()), nil
+		default:
+			return nil, nil
+		}
+	}, nil
+}
+
+func valueTypeName(valueType pcommon.ValueType) interface{} {
+	switch valueType {
+	case pcommon.ValueTypeEmpty:
+		return "Empty"
+	case pcommon.ValueTypeStr:
+		return "String"
+	case pcommon.ValueTypeInt:
+		return "Int"
+	case pcommon.ValueTypeDouble:
+		return "Double"
+	case pcommon.ValueTypeBool:
+		return "Bool"
+	case pcommon.ValueTypeBytes:
+		return "Bytes"
+	case pcommon.ValueTypeMap:
+		return "Map"
+	case pcommon.ValueTypeSlice:
+		return "Slice"
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_TypeOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "string",
+			value:    "a",
+			expected: "String",
+		},
+		{
+			name:     "int",
+			value:    int64(1),
+			expected: "Int",
+		},
+		{
+			name:     "double",
+			value:    1.5,
+			expected: "Double",
+		},
+		{
+			name:     "bool",
+			value:    true,
+			expected: "Bool",
+		},
+		{
+			name:     "map",
+			value:    pcommon.NewMap(),
+			expected: "Map",
+		},
+		{
+			name:     "slice",
+			value:    pcommon.NewSlice(),
+			expected: "Slice",
+		},
+		{
+			name:     "bytes",
+			value:    []byte{1, 2},
+			expected: "Bytes",
+		},
+		{
+			name:     "nil",
+			value:    nil,
+			expected: "Empty",
+		},
+		{
+			name:     "trace ID",
+			value:    pcommon.TraceID([16]byte{1}),
+			expected: "Bytes",
+		},
+		{
+			name:     "span ID",
+			value:    pcommon.SpanID([8]byte{1}),
+			expected: "Bytes",
+		},
+		{
+			name:     "raw map",
+			value:    map[string]interface{}{"a": "b"},
+			expected: "Map",
+		},
+		{
+			name:     "raw slice",
+			value:    []interface{}{"a"},
+			expected: "Slice",
+		},
+		{
+			name:     "string value",
+			value:    pcommon.NewValueStr("a"),
+			expected: "String",
+		},
+		{
+			name:     "int value",
+			value:    pcommon.NewValueInt(1),
+			expected: "Int",
+		},
+		{
+			name:     "double value",
+			value:    pcommon.NewValueDouble(1.5),
+			expected: "Double",
+		},
+		{
+			name:     "bool value",
+			value:    pcommon.NewValueBool(true),
+			expected: "Bool",
+		},
+		{
+			name:     "map value",
+			value:    pcommon.NewValueMap(),
+			expected: "Map",
+		},
+		{
+			name:     "slice value",
+			value:    pcommon.NewValueSlice(),
+			expected: "Slice",
+		},
+		{
+			name:     "bytes value",
+			value:    pcommon.NewValueBytes(),
+			expected: "Bytes",
+		},
+		{
+			name:     "empty value",
+			value:    pcommon.NewValueEmpty(),
+			expected: "Empty",
+		},
+		{
+			name:     "unsupported type",
+			value:    pcommon.NewTimestampFromTime(time.Unix(1, 0)),
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := TypeOf[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// unixUnits are the units of epoch timestamps supported by UnixToTime and TimeToUnix.
+var unixUnits = map[string]time.Duration{
+	"s":  time.Second,
+	"ms": time.Millisecond,
+	"us": time.Microsecond,
+	"ns": time.Nanosecond,
+}
+
+func unixUnit(funcName string, unit string) (time.Duration, error) {
+	d, ok := unixUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf(`the unit supplied to %v must be one of "s", "ms", "us" or "ns", got %q`, funcName, unit)
+	}
+	return d, nil
+}
+
+func UnixToTime[K any](target ottl.Getter[K], unit string) (ottl.ExprFunc[K], error) {
+	d, err := unixUnit("UnixToTime", unit)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch v := val.(type) {
+		case int64:
+			if d == time.Second {
+				return time.Unix(v, 0).UTC(), nil
+			}
+			return time.Unix(0, v*int64(d)).UTC(), nil
+		case float64:
+			return time.Unix(0, int64(v*float64(d))).UTC(), nil
+		}
+		return nil, nil
+	}, nil
+}
@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_UnixToTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		unit     string
+		expected interface{}
+	}{
+		{
+			name:     "seconds",
+			value:    int64(1699999999),
+			unit:     "s",
+			expected: time.Date(2023, 11, 14, 22, 13, 19, 0, time.UTC),
+		},
+		{
+			name:     "milliseconds",
+			value:    int64(1699999999123),
+			unit:     "ms",
+			expected: time.Date(2023, 11, 14, 22, 13, 19, 123000000, time.UTC),
+		},
+		{
+			name:     "microseconds",
+			value:    int64(1699999999123456),
+			unit:     "us",
+			expected: time.Date(2023, 11, 14, 22, 13, 19, 123456000, time.UTC),
+		},
+		{
+			name:     "nanoseconds",
+			value:    int64(1699999999123456789),
+			unit:     "ns",
+			expected: time.Date(2023, 11, 14, 22, 13, 19, 123456789, time.UTC),
+		},
+		{
+			name:     "fractional seconds",
+			value:    1699999999.5,
+			unit:     "s",
+			expected: time.Date(2023, 11, 14, 22, 13, 19, 500000000, time.UTC),
+		},
+		{
+			name:     "negative seconds",
+			value:    int64(-1),
+			unit:     "s",
+			expected: time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:     "string target",
+			value:    "1699999999",
+			unit:     "s",
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			unit:     "s",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := UnixToTime[interface{}](target, tt.unit)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_UnixToTime_invalid_unit(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return int64(1), nil
+		},
+	}
+	exprFunc, err := UnixToTime[interface{}](target, "m")
+	assert.EqualError(t, err, `the unit supplied to UnixToTime must be one of "s", "ms", "us" or "ns", got "m"`)
+	assert.Nil(t, exprFunc)
+}
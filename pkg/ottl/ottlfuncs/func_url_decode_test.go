@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_URLDecode(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "reserved characters",
+			value:    "a+b%26c%3Dd%2Fe%3Ff",
+			expected: "a b&c=d/e?f",
+		},
+		{
+			name:     "unicode",
+			value:    "h%C3%A9llo",
+			expected: "héllo",
+		},
+		{
+			name:     "nothing to decode",
+			value:    "abc-123",
+			expected: "abc-123",
+		},
+		{
+			name:     "non-string target",
+			value:    int64(1),
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := URLDecode[interface{}](target)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_URLDecode_round_trip(t *testing.T) {
+	for _, value := range []string{"", "plain", "a b&c=d/e?f", "100% héllo+wörld", "%zz"} {
+		t.Run(value, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return value, nil
+				},
+			}
+			encode, err := URLEncode[interface{}](target)
+			require.NoError(t, err)
+			decode, err := URLDecode[interface{}](&ottl.StandardGetSetter[interface{}]{Getter: encode})
+			require.NoError(t, err)
+
+			result, err := decode(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, value, result)
+		})
+	}
+}
+
+func Test_URLDecode_malformed(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "100%zz", nil
+		},
+	}
+	exprFunc, err := URLDecode[interface{}](target)
+	require.NoError(t, err)
+	result, err := exprFunc(nil)
+	assert.EqualError(t, err, `could not URL-decode string: invalid URL escape "%zz"`)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,95 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_VersionEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		version  string
+		expected bool
+	}{
+		{
+			name:     "equal version",
+			target:   "1.2.3",
+			version:  "1.2.3",
+			expected: true,
+		},
+		{
+			name:     "equal version with v prefix",
+			target:   "v1.2.3",
+			version:  "1.2.3",
+			expected: true,
+		},
+		{
+			name:     "build metadata is ignored",
+			target:   "1.2.3+linux",
+			version:  "1.2.3+darwin",
+			expected: true,
+		},
+		{
+			name:     "greater version",
+			target:   "1.10.0",
+			version:  "1.1.0",
+			expected: false,
+		},
+		{
+			name:     "pre-release",
+			target:   "1.2.3-rc.1",
+			version:  "1.2.3",
+			expected: false,
+		},
+		{
+			name:     "unparseable target",
+			target:   "1.2.3.4",
+			version:  "1.2.3",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := VersionEqual[interface{}](target, tt.version)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_VersionEqual_invalid_version(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "1.0.0", nil
+		},
+	}
+	exprFunc, err := VersionEqual[interface{}](target, "1.0")
+	assert.EqualError(t, err, "the version supplied to VersionEqual isn't a valid semantic version, got \"1.0\"")
+	assert.Nil(t, exprFunc)
+}
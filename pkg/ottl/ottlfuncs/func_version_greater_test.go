@@ -0,0 +1,141 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+func Test_VersionGreater(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   interface{}
+		version  string
+		expected bool
+	}{
+		{
+			name:     "numeric ordering",
+			target:   "1.10.0",
+			version:  "1.9.0",
+			expected: true,
+		},
+		{
+			name:     "lower version",
+			target:   "1.9.0",
+			version:  "1.10.0",
+			expected: false,
+		},
+		{
+			name:     "equal version",
+			target:   "1.2.3",
+			version:  "1.2.3",
+			expected: false,
+		},
+		{
+			name:     "major version",
+			target:   "2.0.0",
+			version:  "1.99.99",
+			expected: true,
+		},
+		{
+			name:     "v prefix",
+			target:   "v1.2.4",
+			version:  "1.2.3",
+			expected: true,
+		},
+		{
+			name:     "release is greater than pre-release",
+			target:   "1.0.0",
+			version:  "1.0.0-rc.1",
+			expected: true,
+		},
+		{
+			name:     "numeric pre-release identifiers",
+			target:   "1.0.0-rc.10",
+			version:  "1.0.0-rc.9",
+			expected: true,
+		},
+		{
+			name:     "alphanumeric pre-release identifiers",
+			target:   "1.0.0-beta",
+			version:  "1.0.0-alpha.1",
+			expected: true,
+		},
+		{
+			name:     "more pre-release identifiers",
+			target:   "1.0.0-alpha.1",
+			version:  "1.0.0-alpha",
+			expected: true,
+		},
+		{
+			name:     "build metadata is ignored",
+			target:   "1.0.0+build.2",
+			version:  "1.0.0+build.1",
+			expected: false,
+		},
+		{
+			name:     "unparseable target",
+			target:   "1.10",
+			version:  "1.0.0",
+			expected: false,
+		},
+		{
+			name:     "non-string target",
+			target:   int64(2),
+			version:  "1.0.0",
+			expected: false,
+		},
+		{
+			name:     "nil target",
+			target:   nil,
+			version:  "1.0.0",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.target, nil
+				},
+			}
+			exprFunc, err := VersionGreater[interface{}](target, tt.version)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_VersionGreater_invalid_version(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "1.0.0", nil
+		},
+	}
+	for _, version := range []string{"", "1", "1.0", "1.0.0.0", "01.0.0", "1.0.0-", "1.0.0-01", "latest"} {
+		t.Run(version, func(t *testing.T) {
+			exprFunc, err := VersionGreater[interface{}](target, version)
+			assert.EqualError(t, err, "the version supplied to VersionGreater isn't a valid semantic version, got \""+version+"\"")
+			assert.Nil(t, exprFunc)
+		})
+	}
+}
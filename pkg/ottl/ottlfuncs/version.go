@@ -0,0 +1,133 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlfuncs // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottlfuncs"
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// semverRegex matches the semantic versions defined by https://semver.org, optionally prefixed with a v.
+var semverRegex = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*)?$`)
+
+type semver struct {
+	core       [3]uint64
+	prerelease []string
+}
+
+func parseSemver(version string) (semver, bool) {
+	match := semverRegex.FindStringSubmatch(version)
+	if match == nil {
+		return semver{}, false
+	}
+	var v semver
+	for i := range v.core {
+		n, err := strconv.ParseUint(match[i+1], 10, 64)
+		if err != nil {
+			return semver{}, false
+		}
+		v.core[i] = n
+	}
+	if match[4] != "" {
+		v.prerelease = strings.Split(match[4], ".")
+	}
+	return v, true
+}
+
+// compare returns -1, 0 or 1 depending on whether v has a lower, the same or a higher precedence
+// than other. The build metadata doesn't affect the precedence.
+func (v semver) compare(other semver) int {
+	for i := range v.core {
+		switch {
+		case v.core[i] < other.core[i]:
+			return -1
+		case v.core[i] > other.core[i]:
+			return 1
+		}
+	}
+	// a pre-release version has a lower precedence than the normal version
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		if c := comparePrereleaseIdentifiers(v.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.prerelease) < len(other.prerelease):
+		return -1
+	case len(v.prerelease) > len(other.prerelease):
+		return 1
+	}
+	return 0
+}
+
+// comparePrereleaseIdentifiers compares numeric identifiers numerically and other identifiers
+// lexically, numeric identifiers having a lower precedence than the others.
+func comparePrereleaseIdentifiers(a, b string) int {
+	aNum, aErr := strconv.ParseUint(a, 10, 64)
+	bNum, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		switch {
+		case aNum < bNum:
+			return -1
+		case aNum > bNum:
+			return 1
+		}
+		return 0
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareVersion returns a function evaluating whether the target version compares to version as
+// accepted by matches. Targets which aren't semantic versions never match.
+func compareVersion[K any](target ottl.Getter[K], version string, name string, matches func(int) bool) (ottl.ExprFunc[K], error) {
+	parsed, ok := parseSemver(version)
+	if !ok {
+		return nil, fmt.Errorf("the version supplied to %s isn't a valid semantic version, got %q", name, version)
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		valStr, ok := val.(string)
+		if !ok {
+			return false, nil
+		}
+		targetVersion, ok := parseSemver(valStr)
+		if !ok {
+			return false, nil
+		}
+		return matches(targetVersion.compare(parsed)), nil
+	}, nil
+}
@@ -15,9 +15,15 @@
 package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/alecthomas/participle/v2"
 	"go.opentelemetry.io/collector/component"
 	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 type Parser[K any] struct {
@@ -25,66 +31,152 @@ type Parser[K any] struct {
 	pathParser        PathExpressionParser[K]
 	enumParser        EnumParser
 	telemetrySettings component.TelemetrySettings
+	// interner is nil unless the Parser was created with WithStringInterning.
+	interner *Interner
 }
 
 // Statement holds a top level statement for processing telemetry data.
 type Statement[K any] struct {
-	function  ExprFunc[K]
+	// function is nil for statements that only consist of a condition.
+	function ExprFunc[K]
+	// condition is nil when the statement has no where clause, which allows Execute
+	// to skip evaluating a condition entirely.
 	condition boolExpressionEvaluator[K]
+	origText  string
+	// logger is the logger of the Parser's TelemetrySettings. When it is nil, or its level
+	// is above debug, Execute doesn't log anything.
+	logger *zap.Logger
+	// counters is nil unless EnableCounters was called.
+	counters *StatementCounters
 }
 
 // Execute is a function that will execute the statement's function if the statement's condition is met.
 // Returns true if the function was run, returns false otherwise.
 // If the statement contains no condition, the function will run and true will be returned.
 // In addition, the functions return value is always returned.
+// Errors of the condition or the function are wrapped with the statement's text, except ErrBreak,
+// which is returned as is and isn't counted or logged as an error.
+// Statements that only consist of a condition return a nil result and whether the condition is met.
+// A Statement keeps no state between executions, so it can be parsed once and executed for every record.
+// Execute and the evaluation of the condition don't allocate; any allocations come from the paths and functions used by the statement.
 func (s *Statement[K]) Execute(ctx K) (any, bool, error) {
-	condition, err := s.condition(ctx)
-	if err != nil {
-		return nil, false, err
-	}
-	var result any
-	if condition {
-		result, err = s.function(ctx)
+	return s.ExecuteContext(context.Background(), ctx)
+}
+
+// ExecuteContext executes the statement against tCtx like Execute. Lists evaluated by the condition
+// stop being evaluated, returning ctx.Err(), once ctx is done.
+func (s *Statement[K]) ExecuteContext(ctx context.Context, tCtx K) (any, bool, error) {
+	s.counters.recordExecution()
+	if s.condition != nil {
+		condition, err := s.condition(ctx, tCtx)
 		if err != nil {
+			s.counters.recordError()
+			if ce := s.checkDebug("statement condition could not be evaluated"); ce != nil {
+				ce.Write(zap.String("statement", s.origText), zap.Error(err))
+			}
+			return nil, false, fmt.Errorf("failed to execute statement: %v, %w", s.origText, err)
+		}
+		if !condition {
+			s.counters.recordNotMatched()
+			if ce := s.checkDebug("statement condition not matched"); ce != nil {
+				ce.Write(zap.String("statement", s.origText))
+			}
+			return nil, false, nil
+		}
+		if ce := s.checkDebug("statement condition matched"); ce != nil {
+			ce.Write(zap.String("statement", s.origText))
+		}
+	}
+	s.counters.recordMatched()
+	if s.function == nil {
+		return nil, true, nil
+	}
+	result, err := s.function(tCtx)
+	if err != nil {
+		// ErrBreak only stops the execution of the following statements, the function didn't fail.
+		if errors.Is(err, ErrBreak) {
 			return nil, true, err
 		}
+		s.counters.recordError()
+		if ce := s.checkDebug("statement function returned an error"); ce != nil {
+			ce.Write(zap.String("statement", s.origText), zap.Error(err))
+		}
+		return nil, true, fmt.Errorf("failed to execute statement: %v, %w", s.origText, err)
+	}
+	return result, true, nil
+}
+
+// Condition evaluates only the statement's condition, without running its function.
+// Returns true if the statement has no condition.
+func (s *Statement[K]) Condition(ctx K) (bool, error) {
+	if s.condition == nil {
+		return true, nil
+	}
+	return s.condition(context.Background(), ctx)
+}
+
+// checkDebug returns a non-nil entry only if the statement has a logger with debug logging enabled,
+// so that the fields of a log entry are only built when it's written.
+func (s *Statement[K]) checkDebug(msg string) *zapcore.CheckedEntry {
+	if s.logger == nil {
+		return nil
 	}
-	return result, condition, nil
+	return s.logger.Check(zapcore.DebugLevel, msg)
 }
 
-func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings) Parser[K] {
-	return Parser[K]{
+// NewParser returns a Parser that can parse statements using the given functions.
+// An error is returned if the signature of any of the functions can't be used by the Parser.
+func NewParser[K any](functions map[string]interface{}, pathParser PathExpressionParser[K], enumParser EnumParser, telemetrySettings component.TelemetrySettings, options ...Option[K]) (Parser[K], error) {
+	if err := validateFunctions[K](functions); err != nil {
+		return Parser[K]{}, err
+	}
+	p := Parser[K]{
 		functions:         functions,
 		pathParser:        pathParser,
 		enumParser:        enumParser,
 		telemetrySettings: telemetrySettings,
 	}
+	for _, option := range options {
+		option(&p)
+	}
+	return p, nil
 }
 
 func (p *Parser[K]) ParseStatements(statements []string) ([]*Statement[K], error) {
 	var parsedStatements []*Statement[K]
 	var errors error
 
-	for _, statement := range statements {
-		parsed, err := parseStatement(statement)
+	for _, text := range statements {
+		parsed, err := parseStatement(text)
 		if err != nil {
 			errors = multierr.Append(errors, err)
 			continue
 		}
-		function, err := p.newFunctionCall(parsed.Invocation)
-		if err != nil {
-			errors = multierr.Append(errors, err)
-			continue
+		statement := &Statement[K]{
+			origText: text,
+			logger:   p.telemetrySettings.Logger,
 		}
-		expression, err := p.newBooleanExpressionEvaluator(parsed.WhereClause)
-		if err != nil {
-			errors = multierr.Append(errors, err)
-			continue
+		if parsed.Invocation != nil {
+			function, err := p.newFunctionCall(*parsed.Invocation)
+			if err != nil {
+				errors = multierr.Append(errors, err)
+				continue
+			}
+			statement.function = function
+		}
+		condition := parsed.WhereClause
+		if parsed.Condition != nil {
+			condition = parsed.Condition
+		}
+		if condition != nil {
+			expression, err := p.newBooleanExpressionEvaluator(condition)
+			if err != nil {
+				errors = multierr.Append(errors, err)
+				continue
+			}
+			statement.condition = expression
 		}
-		parsedStatements = append(parsedStatements, &Statement[K]{
-			function:  function,
-			condition: expression,
-		})
+		parsedStatements = append(parsedStatements, statement)
 	}
 
 	if errors != nil {
@@ -109,8 +201,12 @@ func newParser() *participle.Parser[parsedStatement] {
 	lex := buildLexer()
 	parser, err := participle.Build[parsedStatement](
 		participle.Lexer(lex),
-		participle.Unquote("String"),
-		participle.Elide("whitespace"),
+		participle.Unquote("String", "QuotedName"),
+		participle.Elide("whitespace", "comment"),
+		// An invocation can either be compared or be a boolean value on its own, and a statement
+		// starting with an invocation can be a condition, which is only known once the whole
+		// invocation has been read.
+		participle.UseLookahead(1024),
 	)
 	if err != nil {
 		panic("Unable to initialize parser; this is a programming error in the transformprocessor:" + err.Error())
@@ -15,12 +15,20 @@
 package ottl
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl/ottltest"
 )
@@ -40,7 +48,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with string",
 			statement: `set("foo")`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -55,7 +63,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with float",
 			statement: `met(1.2)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "met",
 					Arguments: []value{
 						{
@@ -66,11 +74,56 @@ func Test_parse(t *testing.T) {
 				WhereClause: nil,
 			},
 		},
+		{
+			name:      "invocation with exponent float",
+			statement: `met(1e6)`,
+			expected: &parsedStatement{
+				Invocation: &invocation{
+					Function: "met",
+					Arguments: []value{
+						{
+							Float: ottltest.Floatp(1e6),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "invocation with negative exponent float",
+			statement: `met(2.5e-3)`,
+			expected: &parsedStatement{
+				Invocation: &invocation{
+					Function: "met",
+					Arguments: []value{
+						{
+							Float: ottltest.Floatp(2.5e-3),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "invocation with uppercase exponent float",
+			statement: `met(1.0E10)`,
+			expected: &parsedStatement{
+				Invocation: &invocation{
+					Function: "met",
+					Arguments: []value{
+						{
+							Float: ottltest.Floatp(1.0e10),
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
 		{
 			name:      "invocation with int",
 			statement: `fff(12)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "fff",
 					Arguments: []value{
 						{
@@ -85,7 +138,7 @@ func Test_parse(t *testing.T) {
 			name:      "complex invocation",
 			statement: `set("foo", getSomething(bear.honey))`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -119,7 +172,7 @@ func Test_parse(t *testing.T) {
 			name:      "complex path",
 			statement: `set(foo.attributes["bar"].cat, "dog")`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -150,7 +203,7 @@ func Test_parse(t *testing.T) {
 			name:      "where == clause",
 			statement: `set(foo.attributes["bar"].cat, "dog") where name == "fido"`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -201,7 +254,7 @@ func Test_parse(t *testing.T) {
 			name:      "where != clause",
 			statement: `set(foo.attributes["bar"].cat, "dog") where name != "fido"`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -252,7 +305,7 @@ func Test_parse(t *testing.T) {
 			name:      "ignore extra spaces",
 			statement: `set  ( foo.attributes[ "bar"].cat,   "dog")   where name=="fido"`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -303,7 +356,7 @@ func Test_parse(t *testing.T) {
 			name:      "handle quotes",
 			statement: `set("fo\"o")`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -318,7 +371,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with boolean false",
 			statement: `convert_gauge_to_sum("cumulative", false)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "convert_gauge_to_sum",
 					Arguments: []value{
 						{
@@ -336,7 +389,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with boolean true",
 			statement: `convert_gauge_to_sum("cumulative", true)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "convert_gauge_to_sum",
 					Arguments: []value{
 						{
@@ -354,7 +407,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with bytes",
 			statement: `set(attributes["bytes"], 0x0102030405060708)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -379,7 +432,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with nil",
 			statement: `set(attributes["test"], nil)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -404,7 +457,7 @@ func Test_parse(t *testing.T) {
 			name:      "invocation with Enum",
 			statement: `set(attributes["test"], TEST_ENUM)`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -429,7 +482,7 @@ func Test_parse(t *testing.T) {
 			name:      "Invocation with empty list",
 			statement: `set(attributes["test"], [])`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -456,7 +509,7 @@ func Test_parse(t *testing.T) {
 			name:      "Invocation with single-value list",
 			statement: `set(attributes["test"], ["value0"])`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -487,7 +540,7 @@ func Test_parse(t *testing.T) {
 			name:      "Invocation with multi-value list",
 			statement: `set(attributes["test"], ["value1", "value2"])`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -521,7 +574,7 @@ func Test_parse(t *testing.T) {
 			name:      "Invocation with nested heterogeneous types",
 			statement: `set(attributes["test"], [Concat(["a", "b"], "+"), ["1", 2, 3.0], nil, attributes["test"]])`,
 			expected: &parsedStatement{
-				Invocation: invocation{
+				Invocation: &invocation{
 					Function: "set",
 					Arguments: []value{
 						{
@@ -595,6 +648,67 @@ func Test_parse(t *testing.T) {
 				WhereClause: nil,
 			},
 		},
+		{
+			name:      "quoted field name",
+			statement: "set(`weird.field`.sub, `a field`[\"key\"])",
+			expected: &parsedStatement{
+				Invocation: &invocation{
+					Function: "set",
+					Arguments: []value{
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name: "weird.field",
+									},
+									{
+										Name: "sub",
+									},
+								},
+							},
+						},
+						{
+							Path: &Path{
+								Fields: []Field{
+									{
+										Name:   "a field",
+										MapKey: ottltest.Strp("key"),
+									},
+								},
+							},
+						},
+					},
+				},
+				WhereClause: nil,
+			},
+		},
+		{
+			name:      "condition only",
+			statement: `name == "fido"`,
+			expected: &parsedStatement{
+				Condition: &booleanExpression{
+					Left: &term{
+						Left: &booleanValue{
+							Comparison: &comparison{
+								Left: value{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								Op: EQ,
+								Right: value{
+									String: ottltest.Strp("fido"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -628,6 +742,12 @@ func Test_parse_failure(t *testing.T) {
 		`set("foo") where )`,
 		`set("foo") where (name == "fido"))`,
 		`set("foo") where ((name == "fido")`,
+		"set(`weird.field, 1)",
+		"set(weird.field`, 1)",
+		`met(1e)`,
+		`met(1e+)`,
+		`met(2.5e-)`,
+		`met(1ee6)`,
 	}
 	for _, tt := range tests {
 		t.Run(tt, func(t *testing.T) {
@@ -656,7 +776,7 @@ func testParsePath(val *Path) (GetSetter[interface{}], error) {
 // Parse string should start with `set(name, "test") where`...
 func setNameTest(b *booleanExpression) *parsedStatement {
 	return &parsedStatement{
-		Invocation: invocation{
+		Invocation: &invocation{
 			Function: "set",
 			Arguments: []value{
 				{
@@ -782,6 +902,70 @@ func Test_parseWhere(t *testing.T) {
 				},
 			}),
 		},
+		{
+			statement: `true or false and false`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						ConstExpr: booleanp(true),
+					},
+				},
+				Right: []*opOrTerm{
+					{
+						Operator: "or",
+						Term: &term{
+							Left: &booleanValue{
+								ConstExpr: booleanp(false),
+							},
+							Right: []*opAndBooleanValue{
+								{
+									Operator: "and",
+									Value: &booleanValue{
+										ConstExpr: booleanp(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `true and false or false and true`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						ConstExpr: booleanp(true),
+					},
+					Right: []*opAndBooleanValue{
+						{
+							Operator: "and",
+							Value: &booleanValue{
+								ConstExpr: booleanp(false),
+							},
+						},
+					},
+				},
+				Right: []*opOrTerm{
+					{
+						Operator: "or",
+						Term: &term{
+							Left: &booleanValue{
+								ConstExpr: booleanp(false),
+							},
+							Right: []*opAndBooleanValue{
+								{
+									Operator: "and",
+									Value: &booleanValue{
+										ConstExpr: booleanp(true),
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
 		{
 			statement: `(false and true) or false`,
 			expected: setNameTest(&booleanExpression{
@@ -944,6 +1128,214 @@ func Test_parseWhere(t *testing.T) {
 				},
 			}),
 		},
+		{
+			statement: `name between 100 and 500 and name != 200`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
+									},
+								},
+							},
+							Between: &between{
+								Low: value{
+									Int: ottltest.Intp(100),
+								},
+								High: value{
+									Int: ottltest.Intp(500),
+								},
+							},
+						},
+					},
+					Right: []*opAndBooleanValue{
+						{
+							Operator: "and",
+							Value: &booleanValue{
+								Comparison: &comparison{
+									Left: value{
+										Path: &Path{
+											Fields: []Field{
+												{
+													Name: "name",
+												},
+											},
+										},
+									},
+									Op: NE,
+									Right: value{
+										Int: ottltest.Intp(200),
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `name not between 1.5 and 2.5`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
+									},
+								},
+							},
+							Between: &between{
+								Not: true,
+								Low: value{
+									Float: ottltest.Floatp(1.5),
+								},
+								High: value{
+									Float: ottltest.Floatp(2.5),
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `name == TEST_ENUM`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
+									},
+								},
+							},
+							Op: EQ,
+							Right: value{
+								Enum: (*EnumSymbol)(ottltest.Strp("TEST_ENUM")),
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `IsMatch(name, "^a") and true`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Invocation: &invocation{
+							Function: "IsMatch",
+							Arguments: []value{
+								{
+									Path: &Path{
+										Fields: []Field{
+											{
+												Name: "name",
+											},
+										},
+									},
+								},
+								{
+									String: ottltest.Strp("^a"),
+								},
+							},
+						},
+					},
+					Right: []*opAndBooleanValue{
+						{
+							Operator: "and",
+							Value: &booleanValue{
+								ConstExpr: booleanp(true),
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `Len(attributes["x"]) > 0`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Invocation: &invocation{
+									Function: "Len",
+									Arguments: []value{
+										{
+											Path: &Path{
+												Fields: []Field{
+													{
+														Name:   "attributes",
+														MapKey: ottltest.Strp("x"),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							Op: GT,
+							Right: value{
+								Int: ottltest.Intp(0),
+							},
+						},
+					},
+				},
+			}),
+		},
+		{
+			statement: `name == Concat(["a", "b"], "")`,
+			expected: setNameTest(&booleanExpression{
+				Left: &term{
+					Left: &booleanValue{
+						Comparison: &comparison{
+							Left: value{
+								Path: &Path{
+									Fields: []Field{
+										{
+											Name: "name",
+										},
+									},
+								},
+							},
+							Op: EQ,
+							Right: value{
+								Invocation: &invocation{
+									Function: "Concat",
+									Arguments: []value{
+										{
+											List: &list{
+												Values: []value{
+													{String: ottltest.Strp("a")},
+													{String: ottltest.Strp("b")},
+												},
+											},
+										},
+										{
+											String: ottltest.Strp(""),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+		},
 	}
 
 	// create a test name that doesn't confuse vscode so we can rerun tests with one click
@@ -975,33 +1367,53 @@ func testParseEnum(val *EnumSymbol) (*Enum, error) {
 	return nil, fmt.Errorf("enum symbol not provided")
 }
 
+// parseStatementTests is a large range of possible syntaxes along with whether they are expected to parse.
+var parseStatementTests = []struct {
+	statement string
+	wantErr   bool
+}{
+	{`set(foo.attributes["bar"].cat, "dog")`, false},
+	{`set(foo.attributes["animal"], "dog") where animal == "cat"`, false},
+	{`drop() where service == "pinger" or foo.attributes["endpoint"] == "/x/alive"`, false},
+	{`drop() where service == "pinger" or foo.attributes["verb"] == "GET" and foo.attributes["endpoint"] == "/x/alive"`, false},
+	{`drop() where animal > "cat"`, false},
+	{`drop() where animal >= "cat"`, false},
+	{`drop() where animal <= "cat"`, false},
+	{`drop() where animal < "cat"`, false},
+	{`drop() where animal =< "dog"`, true},
+	{`drop() where animal => "dog"`, true},
+	{`drop() where animal <> "dog"`, true},
+	{`drop() where animal = "dog"`, true},
+	{`drop() where animal`, true},
+	{`drop() where animal ==`, true},
+	{`drop() where ==`, true},
+	{`drop() where == animal`, true},
+	{`drop() where attributes["path"] == "/healthcheck"`, false},
+	{`drop() where name contains "health"`, false},
+	{`drop() where name matches "^health"`, false},
+	{`drop() where attributes["path"] matches "/health$" and name contains "check"`, false},
+	{`drop() where name !~ "^health"`, false},
+	{`drop() where name !~ "^health" and attributes["path"] !~ "/ready$"`, false},
+	{`drop() where name !~`, true},
+	{`drop() where name ! ~ "^health"`, true},
+	{`drop() where name contains`, true},
+	{`drop() where contains "health"`, true},
+	{"drop() where `weird.field`.sub == \"dog\"", false},
+	{"drop() where `and` == \"dog\"", false},
+	{"drop() where `unterminated == \"dog\"", true},
+	{`drop() where latency between 100 and 500`, false},
+	{`drop() where latency not between 100 and 500`, false},
+	{`drop() where latency between attributes["low"] and attributes["high"] or latency == 0`, false},
+	{`drop() where latency between 100`, true},
+	{`drop() where latency between 100 or 500`, true},
+	{`drop() where latency not 100`, true},
+}
+
 // This test doesn't validate parser results, simply checks whether the parse succeeds or not.
 // It's a fast way to check a large range of possible syntaxes.
 func Test_parseStatement(t *testing.T) {
-	tests := []struct {
-		statement string
-		wantErr   bool
-	}{
-		{`set(foo.attributes["bar"].cat, "dog")`, false},
-		{`set(foo.attributes["animal"], "dog") where animal == "cat"`, false},
-		{`drop() where service == "pinger" or foo.attributes["endpoint"] == "/x/alive"`, false},
-		{`drop() where service == "pinger" or foo.attributes["verb"] == "GET" and foo.attributes["endpoint"] == "/x/alive"`, false},
-		{`drop() where animal > "cat"`, false},
-		{`drop() where animal >= "cat"`, false},
-		{`drop() where animal <= "cat"`, false},
-		{`drop() where animal < "cat"`, false},
-		{`drop() where animal =< "dog"`, true},
-		{`drop() where animal => "dog"`, true},
-		{`drop() where animal <> "dog"`, true},
-		{`drop() where animal = "dog"`, true},
-		{`drop() where animal`, true},
-		{`drop() where animal ==`, true},
-		{`drop() where ==`, true},
-		{`drop() where == animal`, true},
-		{`drop() where attributes["path"] == "/healthcheck"`, false},
-	}
 	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
-	for _, tt := range tests {
+	for _, tt := range parseStatementTests {
 		name := pat.ReplaceAllString(tt.statement, "_")
 		t.Run(name, func(t *testing.T) {
 			_, err := parseStatement(tt.statement)
@@ -1013,6 +1425,91 @@ func Test_parseStatement(t *testing.T) {
 	}
 }
 
+func Test_parseStatement_comments(t *testing.T) {
+	tests := []struct {
+		name       string
+		statement  string
+		equivalent string
+	}{
+		{
+			name:       "trailing line comment",
+			statement:  `set(name, "a") # rename the span`,
+			equivalent: `set(name, "a")`,
+		},
+		{
+			name:       "line comment before the where clause",
+			statement:  "set(name, \"a\") # rename the span\nwhere name == \"b\"",
+			equivalent: `set(name, "a") where name == "b"`,
+		},
+		{
+			name:       "block comment between tokens",
+			statement:  `set(name, /* the new name */ "a") where /* only */ name == "b"`,
+			equivalent: `set(name, "a") where name == "b"`,
+		},
+		{
+			name:       "multi-line block comment",
+			statement:  "/* rename\n the span */ set(name, \"a\")",
+			equivalent: `set(name, "a")`,
+		},
+		{
+			name:       "comment characters in strings are preserved",
+			statement:  `set(name, "a # b /* c */") # comment`,
+			equivalent: `set(name, "a # b /* c */")`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parseStatement(tt.statement)
+			require.NoError(t, err)
+			expected, err := parseStatement(tt.equivalent)
+			require.NoError(t, err)
+			assert.Equal(t, expected, parsed)
+		})
+	}
+}
+
+func Test_parseStatement_commentInString(t *testing.T) {
+	parsed, err := parseStatement(`set(name, "# not a comment")`)
+	require.NoError(t, err)
+	assert.Equal(t, ottltest.Strp("# not a comment"), parsed.Invocation.Arguments[1].String)
+}
+
+func Test_parseStatement_unterminatedComment(t *testing.T) {
+	_, err := parseStatement(`set(name, "a") /* unterminated`)
+	assert.Error(t, err)
+}
+
+func Test_parsedStatement_String(t *testing.T) {
+	statements := []string{
+		`set("foo", 1.2, 12, -1.0, true, false, nil, 0x0102, TEST_ENUM)`,
+		`set(name, ["list", 1, [attributes["nested"]], Concat(["a", "b"], "-")])`,
+		`set(name, "\"quoted\" \t text")`,
+		`set(name, "test") where (true and false) or (name != nil and foo.attributes["bar"] <= 1)`,
+		`set(name, "test") where IsMatch(name, "^a") and (Len(name) > 1 or IsEmpty(attributes))`,
+		`name == "test" or IsMatch(name, "^a")`,
+		`IsMatch(name, "^a") and Len(name) > 1`,
+	}
+	for _, tt := range parseStatementTests {
+		if !tt.wantErr {
+			statements = append(statements, tt.statement)
+		}
+	}
+
+	pat := regexp.MustCompile("[^a-zA-Z0-9]+")
+	for _, statement := range statements {
+		name := pat.ReplaceAllString(statement, "_")
+		t.Run(name, func(t *testing.T) {
+			parsed, err := parseStatement(statement)
+			assert.NoError(t, err)
+
+			rendered := parsed.String()
+			reparsed, err := parseStatement(rendered)
+			assert.NoError(t, err)
+			assert.Equal(t, parsed, reparsed, "rendered statement: %s", rendered)
+		})
+	}
+}
+
 func Test_Execute(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -1048,6 +1545,15 @@ func Test_Execute(t *testing.T) {
 			expectedCondition: true,
 			expectedResult:    nil,
 		},
+		{
+			name:      "No condition",
+			condition: nil,
+			function: func(ctx interface{}) (interface{}, error) {
+				return 1, nil
+			},
+			expectedCondition: true,
+			expectedResult:    1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1063,3 +1569,231 @@ func Test_Execute(t *testing.T) {
 		})
 	}
 }
+
+func Test_Execute_condition_only(t *testing.T) {
+	p, err := NewParser[interface{}](
+		defaultFunctionsForTests(),
+		testParsePath,
+		testParseEnum,
+		componenttest.NewNopTelemetrySettings(),
+	)
+	require.NoError(t, err)
+	statements, err := p.ParseStatements([]string{`name == "fido"`})
+	require.NoError(t, err)
+
+	result, condition, err := statements[0].Execute("fido")
+	assert.NoError(t, err)
+	assert.True(t, condition)
+	assert.Nil(t, result)
+
+	result, condition, err = statements[0].Execute("rex")
+	assert.NoError(t, err)
+	assert.False(t, condition)
+	assert.Nil(t, result)
+}
+
+func Test_Condition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition boolExpressionEvaluator[interface{}]
+		expected  bool
+	}{
+		{
+			name:      "Condition matched",
+			condition: alwaysTrue[interface{}],
+			expected:  true,
+		},
+		{
+			name:      "Condition not matched",
+			condition: alwaysFalse[interface{}],
+			expected:  false,
+		},
+		{
+			name:      "No condition",
+			condition: nil,
+			expected:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			statement := Statement[interface{}]{
+				condition: tt.condition,
+				function: func(ctx interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				},
+			}
+
+			condition, err := statement.Condition(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, condition)
+			assert.False(t, called)
+
+			_, executed, err := statement.Execute(nil)
+			assert.NoError(t, err)
+			assert.Equal(t, executed, condition)
+		})
+	}
+}
+
+func Test_Condition_error(t *testing.T) {
+	statement := Statement[interface{}]{
+		condition: func(context.Context, interface{}) (bool, error) {
+			return false, errors.New("bad condition")
+		},
+		function: func(ctx interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	}
+
+	condition, err := statement.Condition(nil)
+	assert.EqualError(t, err, "bad condition")
+	assert.False(t, condition)
+}
+
+func Test_Execute_logging(t *testing.T) {
+	functions := map[string]interface{}{
+		"succeed": func() (ExprFunc[interface{}], error) {
+			return func(interface{}) (interface{}, error) {
+				return nil, nil
+			}, nil
+		},
+		"fail": func() (ExprFunc[interface{}], error) {
+			return func(interface{}) (interface{}, error) {
+				return nil, errors.New("failed")
+			}, nil
+		},
+		"stop": func() (ExprFunc[interface{}], error) {
+			return func(interface{}) (interface{}, error) {
+				return nil, ErrBreak
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name      string
+		statement string
+		wantErr   bool
+		expected  []observer.LoggedEntry
+	}{
+		{
+			name:      "matched",
+			statement: `succeed() where name == "bear"`,
+			expected: []observer.LoggedEntry{
+				{
+					Entry: zapcore.Entry{Level: zapcore.DebugLevel, Message: "statement condition matched"},
+					Context: []zapcore.Field{
+						zap.String("statement", `succeed() where name == "bear"`),
+					},
+				},
+			},
+		},
+		{
+			name:      "not matched",
+			statement: `succeed() where name == "cat"`,
+			expected: []observer.LoggedEntry{
+				{
+					Entry: zapcore.Entry{Level: zapcore.DebugLevel, Message: "statement condition not matched"},
+					Context: []zapcore.Field{
+						zap.String("statement", `succeed() where name == "cat"`),
+					},
+				},
+			},
+		},
+		{
+			name:      "errored",
+			statement: `fail()`,
+			wantErr:   true,
+			expected: []observer.LoggedEntry{
+				{
+					Entry: zapcore.Entry{Level: zapcore.DebugLevel, Message: "statement function returned an error"},
+					Context: []zapcore.Field{
+						zap.String("statement", `fail()`),
+						zap.Error(errors.New("failed")),
+					},
+				},
+			},
+		},
+		{
+			name:      "break",
+			statement: `stop()`,
+			wantErr:   true,
+			expected:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			p, err := NewParser[interface{}](functions, testParsePath, testParseEnum, component.TelemetrySettings{Logger: zap.New(core)})
+			require.NoError(t, err)
+			statements, err := p.ParseStatements([]string{tt.statement})
+			require.NoError(t, err)
+
+			_, _, err = statements[0].Execute("bear")
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			entries := logs.AllUntimed()
+			require.Len(t, entries, len(tt.expected))
+			for i, expected := range tt.expected {
+				assert.Equal(t, expected.Level, entries[i].Level)
+				assert.Equal(t, expected.Message, entries[i].Message)
+				assert.Equal(t, expected.Context, entries[i].Context)
+			}
+		})
+	}
+}
+
+func Test_Execute_errorContainsStatement(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+	}{
+		{
+			name:      "function error",
+			statement: `fail()`,
+		},
+		{
+			name:      "condition error",
+			statement: `testing_string("a") where fail()`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			functions := defaultFunctionsForTests()
+			functions["fail"] = func() (ExprFunc[interface{}], error) {
+				return func(interface{}) (interface{}, error) {
+					return nil, errors.New("failed")
+				}, nil
+			}
+			p, err := NewParser[interface{}](functions, testParsePath, testParseEnum, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+			statements, err := p.ParseStatements([]string{tt.statement})
+			require.NoError(t, err)
+
+			_, _, err = statements[0].Execute(nil)
+			assert.EqualError(t, err, "failed to execute statement: "+tt.statement+", failed")
+		})
+	}
+}
+
+func Test_Execute_noLogger(t *testing.T) {
+	statement := Statement[interface{}]{
+		condition: alwaysTrue[interface{}],
+		function: func(interface{}) (interface{}, error) {
+			return nil, errors.New("failed")
+		},
+		origText: "fail()",
+	}
+
+	_, condition, err := statement.Execute(nil)
+	assert.True(t, condition)
+	assert.EqualError(t, err, "failed to execute statement: fail(), failed")
+	// the only allocations are the function's and the wrapping of its error
+	assert.Zero(t, testing.AllocsPerRun(10, func() {
+		_, _, _ = statement.Execute(nil)
+	})-testing.AllocsPerRun(10, func() {
+		_, err := statement.function(nil)
+		_ = fmt.Errorf("failed to execute statement: %v, %w", statement.origText, err)
+	}))
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"sync/atomic"
+)
+
+// StatementCounters counts the outcomes of executing a Statement. It is safe for concurrent use.
+type StatementCounters struct {
+	executions int64
+	matched    int64
+	notMatched int64
+	errored    int64
+}
+
+// EnableCounters attaches StatementCounters to the statement, which are updated every time the statement is executed.
+// Statements don't count their executions unless this is called. Calling it again returns the same counters.
+func (s *Statement[K]) EnableCounters() *StatementCounters {
+	if s.counters == nil {
+		s.counters = &StatementCounters{}
+	}
+	return s.counters
+}
+
+// Counters returns the statement's counters, or nil if EnableCounters hasn't been called.
+func (s *Statement[K]) Counters() *StatementCounters {
+	return s.counters
+}
+
+// Executions returns the number of times the statement was executed.
+func (c *StatementCounters) Executions() int64 {
+	return atomic.LoadInt64(&c.executions)
+}
+
+// Matched returns the number of executions in which the statement's condition was met and its function was called.
+// Executions of statements without a condition are always counted as matched.
+func (c *StatementCounters) Matched() int64 {
+	return atomic.LoadInt64(&c.matched)
+}
+
+// NotMatched returns the number of executions in which the statement's condition was not met.
+func (c *StatementCounters) NotMatched() int64 {
+	return atomic.LoadInt64(&c.notMatched)
+}
+
+// Errored returns the number of executions in which evaluating the condition or calling the function returned an error.
+// A function returning ErrBreak isn't counted as an error.
+func (c *StatementCounters) Errored() int64 {
+	return atomic.LoadInt64(&c.errored)
+}
+
+// The record methods are no-ops on nil counters, so Execute doesn't need to check whether counters are enabled.
+
+func (c *StatementCounters) recordExecution() {
+	if c != nil {
+		atomic.AddInt64(&c.executions, 1)
+	}
+}
+
+func (c *StatementCounters) recordMatched() {
+	if c != nil {
+		atomic.AddInt64(&c.matched, 1)
+	}
+}
+
+func (c *StatementCounters) recordNotMatched() {
+	if c != nil {
+		atomic.AddInt64(&c.notMatched, 1)
+	}
+}
+
+func (c *StatementCounters) recordError() {
+	if c != nil {
+		atomic.AddInt64(&c.errored, 1)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StatementCounters(t *testing.T) {
+	var conditionErr, functionErr error
+	matches := false
+	statement := &Statement[interface{}]{
+		condition: func(context.Context, interface{}) (bool, error) {
+			return matches, conditionErr
+		},
+		function: func(interface{}) (interface{}, error) {
+			return nil, functionErr
+		},
+	}
+	assert.Nil(t, statement.Counters())
+
+	counters := statement.EnableCounters()
+	assert.Same(t, counters, statement.EnableCounters())
+	assert.Same(t, counters, statement.Counters())
+
+	// not matched
+	_, _, err := statement.Execute(nil)
+	assert.NoError(t, err)
+
+	// matched, twice
+	matches = true
+	_, _, err = statement.Execute(nil)
+	assert.NoError(t, err)
+	_, _, err = statement.Execute(nil)
+	assert.NoError(t, err)
+
+	// function error
+	functionErr = errors.New("function failed")
+	_, _, err = statement.Execute(nil)
+	assert.Error(t, err)
+
+	// break
+	functionErr = ErrBreak
+	_, _, err = statement.Execute(nil)
+	assert.ErrorIs(t, err, ErrBreak)
+
+	// condition error
+	conditionErr = errors.New("condition failed")
+	_, _, err = statement.Execute(nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, int64(6), counters.Executions())
+	assert.Equal(t, int64(4), counters.Matched())
+	assert.Equal(t, int64(1), counters.NotMatched())
+	assert.Equal(t, int64(2), counters.Errored())
+}
+
+func Test_StatementCounters_noCondition(t *testing.T) {
+	statement := &Statement[interface{}]{
+		function: func(interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	}
+	counters := statement.EnableCounters()
+
+	_, _, err := statement.Execute(nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int64(1), counters.Executions())
+	assert.Equal(t, int64(1), counters.Matched())
+	assert.Equal(t, int64(0), counters.NotMatched())
+	assert.Equal(t, int64(0), counters.Errored())
+}
+
+func Test_StatementCounters_disabled(t *testing.T) {
+	statement := &Statement[interface{}]{
+		condition: alwaysTrue[interface{}],
+		function: func(interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	}
+
+	assert.Zero(t, testing.AllocsPerRun(100, func() {
+		_, _, _ = statement.Execute(nil)
+	}))
+	assert.Nil(t, statement.Counters())
+}
@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBreak is returned by a function to signal that no further statements should be executed for the current record.
+// Statements.Execute stops evaluating when it encounters ErrBreak and does not report it as an error.
+var ErrBreak = errors.New("break")
+
+// Statements is an ordered list of statements that are executed against the same record.
+type Statements[K any] []*Statement[K]
+
+// Execute executes each statement in order against ctx.
+// If a statement's function returns ErrBreak, the remaining statements are skipped and nil is returned.
+// Any other error stops execution and is returned.
+func (s Statements[K]) Execute(ctx K) error {
+	return s.ExecuteContext(context.Background(), ctx)
+}
+
+// ExecuteContext executes each statement in order against tCtx, like Execute, but checks ctx before each statement.
+// If ctx is done, the remaining statements are skipped and ctx.Err() is returned.
+func (s Statements[K]) ExecuteContext(ctx context.Context, tCtx K) error {
+	for _, statement := range s {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, _, err := statement.ExecuteContext(ctx, tCtx)
+		if err != nil {
+			if errors.Is(err, ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component/componenttest"
+)
+
+func Test_Statements_Execute(t *testing.T) {
+	tests := []struct {
+		name          string
+		statements    []string
+		expectedCalls []string
+		expectedError error
+	}{
+		{
+			name: "all statements executed",
+			statements: []string{
+				`record("first")`,
+				`record("second")`,
+				`break() where false`,
+				`record("third")`,
+			},
+			expectedCalls: []string{"first", "second", "third"},
+		},
+		{
+			name: "break stops later statements",
+			statements: []string{
+				`record("first")`,
+				`break() where name == "dropped"`,
+				`record("second")`,
+				`record("third")`,
+			},
+			expectedCalls: []string{"first"},
+		},
+		{
+			name: "break without condition",
+			statements: []string{
+				`break()`,
+				`record("first")`,
+			},
+			expectedCalls: nil,
+		},
+		{
+			name: "error stops later statements",
+			statements: []string{
+				`record("first")`,
+				`fail()`,
+				`record("second")`,
+			},
+			expectedCalls: []string{"first"},
+			expectedError: errors.New("failed to execute statement: fail(), failed"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			functions := map[string]interface{}{
+				"record": func(s string) (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						calls = append(calls, s)
+						return nil, nil
+					}, nil
+				},
+				"break": func() (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						return nil, ErrBreak
+					}, nil
+				},
+				"fail": func() (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						return nil, errors.New("failed")
+					}, nil
+				},
+			}
+			p, err := NewParser[interface{}](functions, testParsePath, testParseEnum, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+			parsed, err := p.ParseStatements(tt.statements)
+			assert.NoError(t, err)
+
+			err = Statements[interface{}](parsed).Execute("dropped")
+			if tt.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedError.Error())
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func Test_Statements_ExecuteContext(t *testing.T) {
+	tests := []struct {
+		name          string
+		statements    []string
+		cancelFirst   bool
+		expectedCalls []string
+	}{
+		{
+			name: "cancelled during execution",
+			statements: []string{
+				`record("first")`,
+				`cancel()`,
+				`record("second")`,
+				`record("third")`,
+			},
+			expectedCalls: []string{"first"},
+		},
+		{
+			name: "cancelled during the evaluation of a list in a condition",
+			statements: []string{
+				`record("second") where [recorded("first"), cancelled(), recorded("third")] != nil`,
+				`record("fourth")`,
+			},
+			expectedCalls: []string{"first"},
+		},
+		{
+			name: "cancelled before execution",
+			statements: []string{
+				`record("first")`,
+				`record("second")`,
+			},
+			cancelFirst:   true,
+			expectedCalls: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			var calls []string
+			functions := map[string]interface{}{
+				"record": func(s string) (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						calls = append(calls, s)
+						return nil, nil
+					}, nil
+				},
+				"cancel": func() (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						cancel()
+						return nil, nil
+					}, nil
+				},
+				"recorded": func(s string) (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						calls = append(calls, s)
+						return s, nil
+					}, nil
+				},
+				"cancelled": func() (ExprFunc[interface{}], error) {
+					return func(interface{}) (interface{}, error) {
+						cancel()
+						return "cancelled", nil
+					}, nil
+				},
+			}
+			p, err := NewParser[interface{}](functions, testParsePath, testParseEnum, componenttest.NewNopTelemetrySettings())
+			require.NoError(t, err)
+			parsed, err := p.ParseStatements(tt.statements)
+			require.NoError(t, err)
+
+			if tt.cancelFirst {
+				cancel()
+			}
+			err = Statements[interface{}](parsed).ExecuteContext(ctx, nil)
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// GetValue returns the contents of val as the type OTTL uses for it: a string, bool, int64, float64 or []byte
+// for scalar values, and the pcommon.Map or pcommon.Slice itself for maps and slices. Empty values return nil.
+// Getters that read from a pcommon.Value can return its result directly.
+func GetValue(val pcommon.Value) interface{} {
+	switch val.Type() {
+	case pcommon.ValueTypeStr:
+		return val.Str()
+	case pcommon.ValueTypeBool:
+		return val.Bool()
+	case pcommon.ValueTypeInt:
+		return val.Int()
+	case pcommon.ValueTypeDouble:
+		return val.Double()
+	case pcommon.ValueTypeMap:
+		return val.Map()
+	case pcommon.ValueTypeSlice:
+		return val.Slice()
+	case pcommon.ValueTypeBytes:
+		return val.Bytes().AsRaw()
+	}
+	return nil
+}
+
+// SetValue sets value to val, replacing whatever value held before. Maps and slices are copied into value.
+// Besides the types returned by GetValue, slices of strings, bools, int64s, float64s and []byte, as well as
+// map[string]interface{} and []interface{}, are supported. An error is returned for any other type, including nil.
+func SetValue(value pcommon.Value, val interface{}) error {
+	switch v := val.(type) {
+	case string:
+		value.SetStr(v)
+	case bool:
+		value.SetBool(v)
+	case int64:
+		value.SetInt(v)
+	case float64:
+		value.SetDouble(v)
+	case []byte:
+		value.SetEmptyBytes().FromRaw(v)
+	case pcommon.Map:
+		v.CopyTo(value.SetEmptyMap())
+	case pcommon.Slice:
+		v.CopyTo(value.SetEmptySlice())
+	case map[string]interface{}:
+		value.SetEmptyMap().FromRaw(v)
+	case []interface{}:
+		value.SetEmptySlice().FromRaw(v)
+	case []string:
+		s := value.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, str := range v {
+			s.AppendEmpty().SetStr(str)
+		}
+	case []bool:
+		s := value.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, b := range v {
+			s.AppendEmpty().SetBool(b)
+		}
+	case []int64:
+		s := value.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, i := range v {
+			s.AppendEmpty().SetInt(i)
+		}
+	case []float64:
+		s := value.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, f := range v {
+			s.AppendEmpty().SetDouble(f)
+		}
+	case [][]byte:
+		s := value.SetEmptySlice()
+		s.EnsureCapacity(len(v))
+		for _, b := range v {
+			s.AppendEmpty().SetEmptyBytes().FromRaw(b)
+		}
+	default:
+		return fmt.Errorf("unsupported type %T for a pcommon.Value", val)
+	}
+	return nil
+}
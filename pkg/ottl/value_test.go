@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+func Test_Value_round_trip(t *testing.T) {
+	m := pcommon.NewMap()
+	m.PutStr("a", "b")
+	m.PutInt("c", 1)
+
+	s := pcommon.NewSlice()
+	s.AppendEmpty().SetStr("a")
+	s.AppendEmpty().SetDouble(1.5)
+
+	tests := []struct {
+		name         string
+		val          interface{}
+		expectedType pcommon.ValueType
+	}{
+		{
+			name:         "string",
+			val:          "hello",
+			expectedType: pcommon.ValueTypeStr,
+		},
+		{
+			name:         "int",
+			val:          int64(1),
+			expectedType: pcommon.ValueTypeInt,
+		},
+		{
+			name:         "double",
+			val:          1.5,
+			expectedType: pcommon.ValueTypeDouble,
+		},
+		{
+			name:         "bool",
+			val:          true,
+			expectedType: pcommon.ValueTypeBool,
+		},
+		{
+			name:         "bytes",
+			val:          []byte{1, 2, 3},
+			expectedType: pcommon.ValueTypeBytes,
+		},
+		{
+			name:         "map",
+			val:          m,
+			expectedType: pcommon.ValueTypeMap,
+		},
+		{
+			name:         "slice",
+			val:          s,
+			expectedType: pcommon.ValueTypeSlice,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// start from a value of a different type to make sure it's replaced
+			value := pcommon.NewValueStr("previous")
+			if tt.expectedType == pcommon.ValueTypeStr {
+				value = pcommon.NewValueInt(0)
+			}
+
+			require.NoError(t, SetValue(value, tt.val))
+			assert.Equal(t, tt.expectedType, value.Type())
+			assert.Equal(t, tt.val, GetValue(value))
+		})
+	}
+}
+
+func Test_SetValue_native_types(t *testing.T) {
+	tests := []struct {
+		name     string
+		val      interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "strings",
+			val:      []string{"a", "b"},
+			expected: []interface{}{"a", "b"},
+		},
+		{
+			name:     "bools",
+			val:      []bool{true, false},
+			expected: []interface{}{true, false},
+		},
+		{
+			name:     "ints",
+			val:      []int64{1, 2},
+			expected: []interface{}{int64(1), int64(2)},
+		},
+		{
+			name:     "doubles",
+			val:      []float64{1.5, 2.5},
+			expected: []interface{}{1.5, 2.5},
+		},
+		{
+			name:     "bytes",
+			val:      [][]byte{{1}, {2}},
+			expected: []interface{}{[]byte{1}, []byte{2}},
+		},
+		{
+			name:     "interfaces",
+			val:      []interface{}{"a", int64(1)},
+			expected: []interface{}{"a", int64(1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := pcommon.NewValueStr("previous")
+			require.NoError(t, SetValue(value, tt.val))
+			require.Equal(t, pcommon.ValueTypeSlice, value.Type())
+			assert.Equal(t, tt.expected, value.Slice().AsRaw())
+		})
+	}
+
+	t.Run("map", func(t *testing.T) {
+		value := pcommon.NewValueEmpty()
+		require.NoError(t, SetValue(value, map[string]interface{}{"a": "b", "c": int64(1)}))
+		require.Equal(t, pcommon.ValueTypeMap, value.Type())
+		assert.Equal(t, map[string]interface{}{"a": "b", "c": int64(1)}, value.Map().AsRaw())
+	})
+}
+
+func Test_SetValue_unsupported_type(t *testing.T) {
+	value := pcommon.NewValueStr("previous")
+	assert.EqualError(t, SetValue(value, 1), "unsupported type int for a pcommon.Value")
+	assert.EqualError(t, SetValue(value, nil), "unsupported type <nil> for a pcommon.Value")
+	assert.Equal(t, "previous", value.Str())
+}
+
+func Test_GetValue_empty(t *testing.T) {
+	assert.Nil(t, GetValue(pcommon.NewValueEmpty()))
+}
@@ -0,0 +1,109 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ottlgeoip provides the GeoIP OTTL function. It lives in its own module so that the
+// components using OTTL don't depend on the MaxMind DB reader unless they register GeoIP.
+package ottlgeoip // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottlgeoip"
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"sync"
+
+	"github.com/oschwald/maxminddb-golang"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// geoIPRecord holds the fields of MaxMind City databases returned by GeoIP. The coordinates are
+// pointers to tell missing ones from 0.
+type geoIPRecord struct {
+	City struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"city"`
+	Country struct {
+		Names map[string]string `maxminddb:"names"`
+	} `maxminddb:"country"`
+	Location struct {
+		Latitude  *float64 `maxminddb:"latitude"`
+		Longitude *float64 `maxminddb:"longitude"`
+	} `maxminddb:"location"`
+}
+
+// databases holds the databases opened by GeoIP, by path. Statements are parsed again on every
+// configuration validation or reload, so a database is opened once and shared by all the
+// statements using it, instead of being memory-mapped by each of them.
+var databases = struct {
+	sync.Mutex
+	readers map[string]*maxminddb.Reader
+}{readers: map[string]*maxminddb.Reader{}}
+
+func openDatabase(dbPath string) (*maxminddb.Reader, error) {
+	path, err := filepath.Abs(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	databases.Lock()
+	defer databases.Unlock()
+	if db, ok := databases.readers[path]; ok {
+		return db, nil
+	}
+	db, err := maxminddb.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	databases.readers[path] = db
+	return db, nil
+}
+
+func GeoIP[K any](target ottl.Getter[K], dbPath string) (ottl.ExprFunc[K], error) {
+	db, err := openDatabase(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("the database supplied to GeoIP could not be opened: %w", err)
+	}
+	return func(ctx K) (interface{}, error) {
+		val, err := target.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		valStr, ok := val.(string)
+		if !ok {
+			return nil, nil
+		}
+		ip := net.ParseIP(valStr)
+		if ip == nil {
+			return nil, nil
+		}
+		var record geoIPRecord
+		result := pcommon.NewMap()
+		if _, found, err := db.LookupNetwork(ip, &record); err != nil {
+			return nil, fmt.Errorf("could not look up %s in the GeoIP database: %w", valStr, err)
+		} else if !found {
+			return result, nil
+		}
+		if country, ok := record.Country.Names["en"]; ok {
+			result.PutStr("country", country)
+		}
+		if city, ok := record.City.Names["en"]; ok {
+			result.PutStr("city", city)
+		}
+		if record.Location.Latitude != nil && record.Location.Longitude != nil {
+			result.PutDouble("latitude", *record.Location.Latitude)
+			result.PutDouble("longitude", *record.Location.Longitude)
+		}
+		return result, nil
+	}, nil
+}
@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ottlgeoip
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/ottl"
+)
+
+// The test database only contains 81.2.69.0/24 (London), 2001:db8::/32 (Linköping) and
+// 89.160.20.0/24, which is only known to be in France.
+var geoIPTestDatabase = filepath.Join("testdata", "GeoIP2-City-Test.mmdb")
+
+func Test_GeoIP(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:  "IPv4 address",
+			value: "81.2.69.160",
+			expected: map[string]interface{}{
+				"country":   "United Kingdom",
+				"city":      "London",
+				"latitude":  51.5142,
+				"longitude": -0.0931,
+			},
+		},
+		{
+			name:  "IPv6 address",
+			value: "2001:db8::1",
+			expected: map[string]interface{}{
+				"country":   "Sweden",
+				"city":      "Linköping",
+				"latitude":  58.4167,
+				"longitude": 15.6167,
+			},
+		},
+		{
+			name:  "country only",
+			value: "89.160.20.128",
+			expected: map[string]interface{}{
+				"country": "France",
+			},
+		},
+		{
+			name:     "unknown IP",
+			value:    "10.0.0.1",
+			expected: map[string]interface{}{},
+		},
+		{
+			name:     "not an IP",
+			value:    "localhost",
+			expected: nil,
+		},
+		{
+			name:     "non-string target",
+			value:    int64(1),
+			expected: nil,
+		},
+		{
+			name:     "nil target",
+			value:    nil,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := &ottl.StandardGetSetter[interface{}]{
+				Getter: func(ctx interface{}) (interface{}, error) {
+					return tt.value, nil
+				},
+			}
+			exprFunc, err := GeoIP[interface{}](target, geoIPTestDatabase)
+			require.NoError(t, err)
+			result, err := exprFunc(nil)
+			require.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			require.IsType(t, pcommon.Map{}, result)
+			assert.Equal(t, tt.expected, result.(pcommon.Map).AsRaw())
+		})
+	}
+}
+
+func Test_GeoIP_missing_database(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "81.2.69.160", nil
+		},
+	}
+	exprFunc, err := GeoIP[interface{}](target, filepath.Join("testdata", "missing.mmdb"))
+	assert.ErrorContains(t, err, "the database supplied to GeoIP could not be opened:")
+	assert.Nil(t, exprFunc)
+}
+
+func Test_GeoIP_invalid_database(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "81.2.69.160", nil
+		},
+	}
+	exprFunc, err := GeoIP[interface{}](target, "geoip.go")
+	assert.ErrorContains(t, err, "the database supplied to GeoIP could not be opened:")
+	assert.Nil(t, exprFunc)
+}
+
+func Test_GeoIP_shared_database(t *testing.T) {
+	target := &ottl.StandardGetSetter[interface{}]{
+		Getter: func(ctx interface{}) (interface{}, error) {
+			return "81.2.69.160", nil
+		},
+	}
+	for i := 0; i < 3; i++ {
+		_, err := GeoIP[interface{}](target, geoIPTestDatabase)
+		require.NoError(t, err)
+	}
+	_, err := GeoIP[interface{}](target, "./"+geoIPTestDatabase)
+	require.NoError(t, err)
+
+	// the database is opened only once, whatever the number of statements using it
+	path, err := filepath.Abs(geoIPTestDatabase)
+	require.NoError(t, err)
+	databases.Lock()
+	defer databases.Unlock()
+	assert.Len(t, databases.readers, 1)
+	assert.Contains(t, databases.readers, path)
+}
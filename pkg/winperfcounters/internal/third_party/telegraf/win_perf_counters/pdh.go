@@ -160,6 +160,9 @@ const (
 	PERF_DETAIL_STANDARD = 0x0000FFFF
 )
 
+// PDH_MAX_COUNTER_NAME is the maximum length, in characters, of a counter or object name.
+const PDH_MAX_COUNTER_NAME = 1024
+
 type (
 	PDH_HQUERY   HANDLE // query handle
 	PDH_HCOUNTER HANDLE // counter handle
@@ -177,10 +180,13 @@ var (
 	pdh_CollectQueryDataWithTime  *syscall.Proc
 	pdh_GetFormattedCounterValue  *syscall.Proc
 	pdh_GetFormattedCounterArrayW *syscall.Proc
+	pdh_GetRawCounterArrayW       *syscall.Proc
 	pdh_OpenQuery                 *syscall.Proc
 	pdh_ValidatePathW             *syscall.Proc
 	pdh_ExpandWildCardPathW       *syscall.Proc
 	pdh_GetCounterInfoW           *syscall.Proc
+	pdh_LookupPerfNameByIndexW    *syscall.Proc
+	pdh_ConnectMachineW           *syscall.Proc
 )
 
 func init() {
@@ -195,10 +201,13 @@ func init() {
 	pdh_CollectQueryDataWithTime, _ = libpdhDll.FindProc("PdhCollectQueryDataWithTime")
 	pdh_GetFormattedCounterValue = libpdhDll.MustFindProc("PdhGetFormattedCounterValue")
 	pdh_GetFormattedCounterArrayW = libpdhDll.MustFindProc("PdhGetFormattedCounterArrayW")
+	pdh_GetRawCounterArrayW = libpdhDll.MustFindProc("PdhGetRawCounterArrayW")
 	pdh_OpenQuery = libpdhDll.MustFindProc("PdhOpenQuery")
 	pdh_ValidatePathW = libpdhDll.MustFindProc("PdhValidatePathW")
 	pdh_ExpandWildCardPathW = libpdhDll.MustFindProc("PdhExpandWildCardPathW")
 	pdh_GetCounterInfoW = libpdhDll.MustFindProc("PdhGetCounterInfoW")
+	pdh_LookupPerfNameByIndexW = libpdhDll.MustFindProc("PdhLookupPerfNameByIndexW")
+	pdh_ConnectMachineW = libpdhDll.MustFindProc("PdhConnectMachineW")
 }
 
 // PdhAddCounter adds the specified counter to the query. This is the internationalized version. Preferably, use the
@@ -399,6 +408,20 @@ func PdhGetFormattedCounterArrayDouble(hCounter PDH_HCOUNTER, lpdwBufferSize *ui
 	return uint32(ret)
 }
 
+// PdhGetRawCounterArray returns an array of raw values from the specified counter. Use this function when you want to retrieve the raw
+// counter values of a counter that contains a wildcard character for the instance name. The itemBuffer must be a slice of type
+// PDH_RAW_COUNTER_ITEM. The buffer is sized the same way as for PdhGetFormattedCounterArrayDouble: call it first with a nil itemBuffer
+// to receive PDH_MORE_DATA and the required buffer size.
+func PdhGetRawCounterArray(hCounter PDH_HCOUNTER, lpdwBufferSize *uint32, lpdwBufferCount *uint32, itemBuffer *byte) uint32 {
+	ret, _, _ := pdh_GetRawCounterArrayW.Call(
+		uintptr(hCounter),
+		uintptr(unsafe.Pointer(lpdwBufferSize)),
+		uintptr(unsafe.Pointer(lpdwBufferCount)),
+		uintptr(unsafe.Pointer(itemBuffer)))
+
+	return uint32(ret)
+}
+
 // PdhOpenQuery creates a new query that is used to manage the collection of performance data.
 // szDataSource is a null terminated string that specifies the name of the log file from which to
 // retrieve the performance data. If 0, performance data is collected from a real-time data source.
@@ -468,6 +491,37 @@ func PdhValidatePath(path string) uint32 {
 	return uint32(ret)
 }
 
+// PdhLookupPerfNameByIndex returns the performance object name or counter name corresponding to the specified index.
+// The name is returned in the language of the system. szMachineName is empty to look up the name on the local computer.
+// pcchNameBufferSize is the size of szNameBuffer in characters, and is set to the length of the returned name.
+func PdhLookupPerfNameByIndex(szMachineName string, dwNameIndex uint32, szNameBuffer *uint16, pcchNameBufferSize *uint32) uint32 {
+	var machine uintptr
+	if szMachineName != "" {
+		ptxt, _ := syscall.UTF16PtrFromString(szMachineName)
+		machine = uintptr(unsafe.Pointer(ptxt))
+	}
+	ret, _, _ := pdh_LookupPerfNameByIndexW.Call(
+		machine,
+		uintptr(dwNameIndex),
+		uintptr(unsafe.Pointer(szNameBuffer)),
+		uintptr(unsafe.Pointer(pcchNameBufferSize)))
+
+	return uint32(ret)
+}
+
+// PdhConnectMachine connects to the specified computer. Returns PDH_CSTATUS_NO_MACHINE when the computer can't be
+// reached. szMachineName is empty to connect to the local computer.
+func PdhConnectMachine(szMachineName string) uint32 {
+	var machine uintptr
+	if szMachineName != "" {
+		ptxt, _ := syscall.UTF16PtrFromString(szMachineName)
+		machine = uintptr(unsafe.Pointer(ptxt))
+	}
+	ret, _, _ := pdh_ConnectMachineW.Call(machine)
+
+	return uint32(ret)
+}
+
 func PdhFormatError(msgId uint32) string {
 	var flags uint32 = windows.FORMAT_MESSAGE_FROM_HMODULE | windows.FORMAT_MESSAGE_ARGUMENT_ARRAY | windows.FORMAT_MESSAGE_IGNORE_INSERTS
 	buf := make([]uint16, 300)
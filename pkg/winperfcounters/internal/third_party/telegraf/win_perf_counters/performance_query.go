@@ -28,6 +28,7 @@ type PerformanceQuery interface {
 	ExpandWildCardPath(counterPath string) ([]string, error)
 	GetFormattedCounterValueDouble(hCounter PDH_HCOUNTER) (float64, error)
 	GetFormattedCounterArrayDouble(hCounter PDH_HCOUNTER) ([]CounterValue, error)
+	GetRawCounterArray(hCounter PDH_HCOUNTER) ([]CounterValue, error)
 	CollectData() error
 	CollectDataWithTime() (time.Time, error)
 	IsVistaOrNewer() bool
@@ -183,6 +184,30 @@ func (m *PerformanceQueryImpl) GetFormattedCounterArrayDouble(hCounter PDH_HCOUN
 	return nil, NewPdhError(ret)
 }
 
+// GetRawCounterArray returns the raw, unformatted values of the specified counter. The first raw value of each instance is returned.
+func (m *PerformanceQueryImpl) GetRawCounterArray(hCounter PDH_HCOUNTER) ([]CounterValue, error) {
+	var buffSize uint32
+	var itemCount uint32
+	var ret uint32
+
+	if ret = PdhGetRawCounterArray(hCounter, &buffSize, &itemCount, nil); ret == PDH_MORE_DATA {
+		buff := make([]byte, buffSize)
+
+		if ret = PdhGetRawCounterArray(hCounter, &buffSize, &itemCount, &buff[0]); ret == ERROR_SUCCESS {
+			items := unsafe.Slice((*PDH_RAW_COUNTER_ITEM)(unsafe.Pointer(&buff[0])), itemCount)
+			values := make([]CounterValue, 0, itemCount)
+			for _, item := range items {
+				if item.RawValue.CStatus == PDH_CSTATUS_VALID_DATA || item.RawValue.CStatus == PDH_CSTATUS_NEW_DATA {
+					val := CounterValue{UTF16PtrToString(item.SzName), float64(item.RawValue.FirstValue)}
+					values = append(values, val)
+				}
+			}
+			return values, nil
+		}
+	}
+	return nil, NewPdhError(ret)
+}
+
 func (m *PerformanceQueryImpl) CollectData() error {
 	var ret uint32
 	if m.query == 0 {
@@ -210,6 +235,25 @@ func (m *PerformanceQueryImpl) IsVistaOrNewer() bool {
 	return PdhAddEnglishCounterSupported()
 }
 
+// LookupPerfNameByIndex returns the localized name of the performance object or counter with the given index
+// on the given machine, or on the local machine if machine is empty
+func LookupPerfNameByIndex(machine string, index uint32) (string, error) {
+	buf := make([]uint16, PDH_MAX_COUNTER_NAME)
+	size := uint32(len(buf))
+	if ret := PdhLookupPerfNameByIndex(machine, index, &buf[0], &size); ret != ERROR_SUCCESS {
+		return "", NewPdhError(ret)
+	}
+	return syscall.UTF16ToString(buf), nil
+}
+
+// ConnectMachine connects to the given remote machine, so its counters can be added to queries
+func ConnectMachine(machine string) error {
+	if ret := PdhConnectMachine(machine); ret != ERROR_SUCCESS {
+		return NewPdhError(ret)
+	}
+	return nil
+}
+
 // UTF16PtrToString converts Windows API LPTSTR (pointer to string) to go string
 func UTF16PtrToString(s *uint16) string {
 	if s == nil {
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package winperfcounters // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// ParseNameIndex reports whether name refers to a performance object or counter by its numeric
+// PDH index rather than by its name, and returns the index if so. Names made up only of digits
+// are treated as indices. Indices are locale-independent, whereas names differ between Windows
+// display languages. An error is returned if name is made up of digits but is not a valid index.
+func ParseNameIndex(name string) (uint32, bool, error) {
+	if name == "" {
+		return 0, false, nil
+	}
+	for _, r := range name {
+		if r < '0' || r > '9' {
+			return 0, false, nil
+		}
+	}
+	index, err := strconv.ParseUint(name, 10, 32)
+	if err != nil || index == 0 {
+		return 0, true, fmt.Errorf("%q is not a valid performance counter index", name)
+	}
+	return uint32(index), true, nil
+}
@@ -0,0 +1,51 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package winperfcounters // import "github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNameIndex(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         string
+		expectedIndex uint32
+		expectedOK    bool
+		expectedErr   string
+	}{
+		{name: "name", input: "Processor"},
+		{name: "empty", input: ""},
+		{name: "mixed", input: "238a"},
+		{name: "index", input: "238", expectedIndex: 238, expectedOK: true},
+		{name: "zero", input: "0", expectedOK: true, expectedErr: `"0" is not a valid performance counter index`},
+		{name: "overflow", input: "4294967296", expectedOK: true, expectedErr: `"4294967296" is not a valid performance counter index`},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			index, ok, err := ParseNameIndex(test.input)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedIndex, index)
+			assert.Equal(t, test.expectedOK, ok)
+		})
+	}
+}
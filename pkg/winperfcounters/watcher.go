@@ -19,6 +19,7 @@ package winperfcounters // import "github.com/open-telemetry/opentelemetry-colle
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters/internal/third_party/telegraf/win_perf_counters"
@@ -44,28 +45,99 @@ type perfCounter struct {
 	path   string
 	query  win_perf_counters.PerformanceQuery
 	handle win_perf_counters.PDH_HCOUNTER
+	raw    bool
 }
 
-// NewWatcher creates new PerfCounterWatcher by provided parts of its path.
+// NewWatcher creates new PerfCounterWatcher by provided parts of its path. The object and counter
+// name may be given as numeric PDH indices, see ParseNameIndex, in which case both must be indices.
 func NewWatcher(object, instance, counterName string) (PerfCounterWatcher, error) {
-	path := counterPath(object, instance, counterName)
-	counter, err := newPerfCounter(path, true)
+	return newWatcher("", object, instance, counterName, false)
+}
+
+// NewRawWatcher creates new PerfCounterWatcher by provided parts of its path. The returned watcher
+// scrapes the raw counter values instead of the formatted values.
+func NewRawWatcher(object, instance, counterName string) (PerfCounterWatcher, error) {
+	return newWatcher("", object, instance, counterName, true)
+}
+
+// NewRemoteWatcher creates new PerfCounterWatcher for a counter of the given machine. The local
+// machine is used if machine is empty.
+func NewRemoteWatcher(machine, object, instance, counterName string) (PerfCounterWatcher, error) {
+	return newWatcher(machine, object, instance, counterName, false)
+}
+
+// NewRemoteRawWatcher creates new PerfCounterWatcher for a counter of the given machine. The
+// returned watcher scrapes the raw counter values instead of the formatted values.
+func NewRemoteRawWatcher(machine, object, instance, counterName string) (PerfCounterWatcher, error) {
+	return newWatcher(machine, object, instance, counterName, true)
+}
+
+// ConnectMachine checks that the counters of the given remote machine can be queried.
+func ConnectMachine(machine string) error {
+	if err := win_perf_counters.ConnectMachine(machineName(machine)); err != nil {
+		return fmt.Errorf("failed to connect to machine %v: %w", machine, err)
+	}
+	return nil
+}
+
+func newWatcher(machine, object, instance, counterName string, raw bool) (PerfCounterWatcher, error) {
+	resolvedObject, objectLocalized, err := resolveName(machine, object)
+	if err != nil {
+		return nil, err
+	}
+	resolvedCounterName, counterLocalized, err := resolveName(machine, counterName)
+	if err != nil {
+		return nil, err
+	}
+	// Indices resolve to localized names, which PDH only accepts in a path that is localized as a
+	// whole, while names are expected in English. A path mixing both can't be added either way.
+	if objectLocalized != counterLocalized {
+		return nil, fmt.Errorf("object %q and counter %q must either both be PDH indices or both be names", object, counterName)
+	}
+
+	path := counterPath(machine, resolvedObject, instance, resolvedCounterName)
+	counter, err := newPerfCounter(path, objectLocalized, true)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create perf counter with path %v: %w", path, err)
 	}
+	counter.raw = raw
 	return counter, nil
 }
 
-func counterPath(object, instance, counterName string) string {
+// resolveName returns the localized name for the given name if it is a numeric PDH index.
+// Otherwise name is returned unchanged. The returned bool reports whether name was resolved.
+func resolveName(machine, name string) (string, bool, error) {
+	index, ok, err := ParseNameIndex(name)
+	if err != nil || !ok {
+		return name, false, err
+	}
+	localized, err := win_perf_counters.LookupPerfNameByIndex(machineName(machine), index)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to look up perf counter name with index %d: %w", index, err)
+	}
+	return localized, true, nil
+}
+
+// machineName returns the machine in the \\machine form expected by PDH. It returns an empty
+// string for the local machine.
+func machineName(machine string) string {
+	if machine == "" {
+		return ""
+	}
+	return "\\\\" + strings.TrimPrefix(machine, "\\\\")
+}
+
+func counterPath(machine, object, instance, counterName string) string {
 	if instance != "" {
 		instance = fmt.Sprintf("(%s)", instance)
 	}
 
-	return fmt.Sprintf("\\%s%s\\%s", object, instance, counterName)
+	return fmt.Sprintf("%s\\%s%s\\%s", machineName(machine), object, instance, counterName)
 }
 
-// newPerfCounter returns a new performance counter for the specified descriptor.
-func newPerfCounter(counterPath string, collectOnStartup bool) (*perfCounter, error) {
+// newPerfCounter returns a new performance counter for the specified descriptor. If localized is
+// set, counterPath is expected to use the names of the system's display language instead of English.
+func newPerfCounter(counterPath string, localized bool, collectOnStartup bool) (*perfCounter, error) {
 	query := &win_perf_counters.PerformanceQueryImpl{}
 	err := query.Open()
 	if err != nil {
@@ -73,7 +145,11 @@ func newPerfCounter(counterPath string, collectOnStartup bool) (*perfCounter, er
 	}
 
 	var handle win_perf_counters.PDH_HCOUNTER
-	handle, err = query.AddEnglishCounterToQuery(counterPath)
+	if localized {
+		handle, err = query.AddCounterToQuery(counterPath)
+	} else {
+		handle, err = query.AddEnglishCounterToQuery(counterPath)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +196,13 @@ func (pc *perfCounter) ScrapeData() ([]CounterValue, error) {
 		}
 	}
 
-	vals, err := pc.query.GetFormattedCounterArrayDouble(pc.handle)
+	var vals []CounterValue
+	var err error
+	if pc.raw {
+		vals, err = pc.query.GetRawCounterArray(pc.handle)
+	} else {
+		vals, err = pc.query.GetFormattedCounterArrayDouble(pc.handle)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to format data for performance counter '%s': %w", pc.path, err)
 	}
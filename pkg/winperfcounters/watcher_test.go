@@ -27,6 +27,7 @@ import (
 func TestCounterPath(t *testing.T) {
 	testCases := []struct {
 		name         string
+		machine      string
 		object       string
 		instance     string
 		counterName  string
@@ -45,11 +46,26 @@ func TestCounterPath(t *testing.T) {
 			counterName:  "Current Connections",
 			expectedPath: "\\Web Service(_Total)\\Current Connections",
 		},
+		{
+			name:         "remotePath",
+			machine:      "server-1",
+			object:       "Memory",
+			counterName:  "Committed Bytes",
+			expectedPath: "\\\\server-1\\Memory\\Committed Bytes",
+		},
+		{
+			name:         "remotePathWithInstance",
+			machine:      "\\\\server-1",
+			object:       "Web Service",
+			instance:     "_Total",
+			counterName:  "Current Connections",
+			expectedPath: "\\\\server-1\\Web Service(_Total)\\Current Connections",
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			path := counterPath(test.object, test.instance, test.counterName)
+			path := counterPath(test.machine, test.object, test.instance, test.counterName)
 			require.Equal(t, test.expectedPath, path)
 		})
 	}
@@ -66,15 +82,27 @@ func Test_Scraping_Wildcard(t *testing.T) {
 	require.GreaterOrEqual(t, len(values), 3)
 }
 
+// Test_Scraping_Raw tests that raw watchers pull out the raw counter values
+func Test_Scraping_Raw(t *testing.T) {
+	watcher, err := NewRawWatcher("Memory", "", "Committed Bytes")
+	require.NoError(t, err)
+
+	values, err := watcher.ScrapeData()
+	require.NoError(t, err)
+
+	require.Len(t, values, 1)
+	assert.Greater(t, values[0].Value, float64(0))
+}
+
 func TestNewPerfCounter_InvalidPath(t *testing.T) {
-	_, err := newPerfCounter("Invalid Counter Path", false)
+	_, err := newPerfCounter("Invalid Counter Path", false, false)
 	if assert.Error(t, err) {
 		assert.Regexp(t, "^Unable to parse the counter path", err.Error())
 	}
 }
 
 func TestNewPerfCounter(t *testing.T) {
-	pc, err := newPerfCounter(`\Memory\Committed Bytes`, false)
+	pc, err := newPerfCounter(`\Memory\Committed Bytes`, false, false)
 	require.NoError(t, err, "Failed to create performance counter: %v", err)
 
 	assert.NotNil(t, pc.query)
@@ -91,7 +119,7 @@ func TestNewPerfCounter(t *testing.T) {
 }
 
 func TestNewPerfCounter_CollectOnStartup(t *testing.T) {
-	pc, err := newPerfCounter(`\Memory\Committed Bytes`, true)
+	pc, err := newPerfCounter(`\Memory\Committed Bytes`, false, true)
 	require.NoError(t, err, "Failed to create performance counter: %v", err)
 
 	assert.NotNil(t, pc.query)
@@ -108,7 +136,7 @@ func TestNewPerfCounter_CollectOnStartup(t *testing.T) {
 }
 
 func TestPerfCounter_Close(t *testing.T) {
-	pc, err := newPerfCounter(`\Memory\Committed Bytes`, false)
+	pc, err := newPerfCounter(`\Memory\Committed Bytes`, false, false)
 	require.NoError(t, err)
 
 	err = pc.Close()
@@ -158,7 +186,7 @@ func TestPerfCounter_ScrapeData(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			pc, err := newPerfCounter(test.path, false)
+			pc, err := newPerfCounter(test.path, false, false)
 			require.NoError(t, err)
 
 			data, err := pc.ScrapeData()
@@ -168,3 +196,24 @@ func TestPerfCounter_ScrapeData(t *testing.T) {
 		})
 	}
 }
+
+// Test_Scraping_Index tests that objects and counters can be referenced by their PDH index
+func Test_Scraping_Index(t *testing.T) {
+	// 4 is the index of the "Memory" object and 26 the index of its "Committed Bytes" counter
+	watcher, err := NewWatcher("4", "", "26")
+	require.NoError(t, err)
+
+	values, err := watcher.ScrapeData()
+	require.NoError(t, err)
+
+	require.Len(t, values, 1)
+	assert.Greater(t, values[0].Value, float64(0))
+}
+
+func Test_Scraping_Index_Mixed(t *testing.T) {
+	_, err := NewWatcher("4", "", "Committed Bytes")
+	assert.EqualError(t, err, `object "4" and counter "Committed Bytes" must either both be PDH indices or both be names`)
+
+	_, err = NewWatcher("Memory", "", "26")
+	assert.EqualError(t, err, `object "Memory" and counter "26" must either both be PDH indices or both be names`)
+}
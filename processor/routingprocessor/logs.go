@@ -39,9 +39,14 @@ type logProcessor struct {
 	router    router[component.LogsExporter, ottllogs.TransformContext]
 }
 
-func newLogProcessor(settings component.TelemetrySettings, config config.Processor) *logProcessor {
+func newLogProcessor(settings component.TelemetrySettings, config config.Processor) (*logProcessor, error) {
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	parser, err := ottllogs.NewParser(common.Functions[ottllogs.TransformContext](), settings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &logProcessor{
 		logger: settings.Logger,
 		config: cfg,
@@ -49,10 +54,10 @@ func newLogProcessor(settings component.TelemetrySettings, config config.Process
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottllogs.NewParser(common.Functions[ottllogs.TransformContext](), settings),
+			parser,
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
-	}
+	}, nil
 }
 
 func (p *logProcessor) Start(_ context.Context, host component.Host) error {
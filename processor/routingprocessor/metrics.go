@@ -39,9 +39,14 @@ type metricsProcessor struct {
 	router    router[component.MetricsExporter, ottldatapoints.TransformContext]
 }
 
-func newMetricProcessor(settings component.TelemetrySettings, config config.Processor) *metricsProcessor {
+func newMetricProcessor(settings component.TelemetrySettings, config config.Processor) (*metricsProcessor, error) {
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	parser, err := ottldatapoints.NewParser(common.Functions[ottldatapoints.TransformContext](), settings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &metricsProcessor{
 		logger: settings.Logger,
 		config: cfg,
@@ -49,10 +54,10 @@ func newMetricProcessor(settings component.TelemetrySettings, config config.Proc
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottldatapoints.NewParser(common.Functions[ottldatapoints.TransformContext](), settings),
+			parser,
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
-	}
+	}, nil
 }
 
 func (p *metricsProcessor) Start(_ context.Context, host component.Host) error {
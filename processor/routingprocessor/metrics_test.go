@@ -40,7 +40,8 @@ func TestMetricProcessorCapabilities(t *testing.T) {
 	}
 
 	// test
-	p := newMetricProcessor(component.TelemetrySettings{}, config)
+	p, err := newMetricProcessor(component.TelemetrySettings{}, config)
+	require.NoError(t, err)
 	require.NotNil(t, p)
 
 	// verify
@@ -63,7 +64,7 @@ func TestMetrics_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 		},
 	}
 
-	exp := newMetricProcessor(component.TelemetrySettings{}, &Config{
+	exp, err := newMetricProcessor(component.TelemetrySettings{}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -74,6 +75,7 @@ func TestMetrics_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 
 	m := pmetric.NewMetrics()
 
@@ -126,7 +128,7 @@ func TestMetrics_RoutingWorks_Context(t *testing.T) {
 		},
 	}
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  contextAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -137,6 +139,7 @@ func TestMetrics_RoutingWorks_Context(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	m := pmetric.NewMetrics()
@@ -190,7 +193,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute(t *testing.T) {
 		},
 	}
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -201,6 +204,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	t.Run("non default route is properly used", func(t *testing.T) {
@@ -248,7 +252,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing
 		},
 	}
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		AttributeSource:              resourceAttributeSource,
 		FromAttribute:                "X-Tenant",
 		DropRoutingResourceAttribute: true,
@@ -260,6 +264,7 @@ func TestMetrics_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	m := pmetric.NewMetrics()
@@ -313,7 +318,8 @@ func Benchmark_MetricsRouting_ResourceAttribute(b *testing.B) {
 			},
 		}
 
-		exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, cfg)
+		exp, err := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, cfg)
+		require.NoError(b, err)
 		assert.NoError(b, exp.Start(context.Background(), host))
 
 		for i := 0; i < b.N; i++ {
@@ -350,7 +356,7 @@ func TestMetricsAreCorrectlySplitPerResourceAttributeRoutingWithOTTL(t *testing.
 		},
 	}
 
-	exp := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newMetricProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		DefaultExporters: []string{"otlp"},
 		Table: []RoutingTableItem{
 			{
@@ -363,6 +369,7 @@ func TestMetricsAreCorrectlySplitPerResourceAttributeRoutingWithOTTL(t *testing.
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	t.Run("metric matched by no expressions", func(t *testing.T) {
@@ -39,9 +39,14 @@ type tracesProcessor struct {
 	router    router[component.TracesExporter, ottltraces.TransformContext]
 }
 
-func newTracesProcessor(settings component.TelemetrySettings, config config.Processor) *tracesProcessor {
+func newTracesProcessor(settings component.TelemetrySettings, config config.Processor) (*tracesProcessor, error) {
 	cfg := rewriteRoutingEntriesToOTTL(config.(*Config))
 
+	parser, err := ottltraces.NewParser(common.Functions[ottltraces.TransformContext](), settings)
+	if err != nil {
+		return nil, err
+	}
+
 	return &tracesProcessor{
 		logger: settings.Logger,
 		config: cfg,
@@ -49,10 +54,10 @@ func newTracesProcessor(settings component.TelemetrySettings, config config.Proc
 			cfg.Table,
 			cfg.DefaultExporters,
 			settings,
-			ottltraces.NewParser(common.Functions[ottltraces.TransformContext](), settings),
+			parser,
 		),
 		extractor: newExtractor(cfg.FromAttribute, settings.Logger),
-	}
+	}, nil
 }
 
 func (p *tracesProcessor) Start(_ context.Context, host component.Host) error {
@@ -33,7 +33,7 @@ import (
 
 func TestTraces_RegisterExportersForValidRoute(t *testing.T) {
 	// prepare
-	exp := newTracesProcessor(component.TelemetrySettings{}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{}, &Config{
 		DefaultExporters: []string{"otlp"},
 		FromAttribute:    "X-Tenant",
 		Table: []RoutingTableItem{
@@ -43,6 +43,7 @@ func TestTraces_RegisterExportersForValidRoute(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 
 	otlpExpFactory := otlpexporter.NewFactory()
 	otlpConfig := &otlpexporter.Config{
@@ -74,7 +75,7 @@ func TestTraces_RegisterExportersForValidRoute(t *testing.T) {
 
 func TestTraces_InvalidExporter(t *testing.T) {
 	//  prepare
-	exp := newTracesProcessor(component.TelemetrySettings{}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{}, &Config{
 		DefaultExporters: []string{"otlp"},
 		FromAttribute:    "X-Tenant",
 		Table: []RoutingTableItem{
@@ -84,6 +85,7 @@ func TestTraces_InvalidExporter(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 
 	host := &mockHost{
 		Host: componenttest.NewNopHost(),
@@ -97,7 +99,7 @@ func TestTraces_InvalidExporter(t *testing.T) {
 	}
 
 	// test
-	err := exp.Start(context.Background(), host)
+	err = exp.Start(context.Background(), host)
 
 	// verify
 	assert.Error(t, err)
@@ -119,7 +121,7 @@ func TestTraces_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 		},
 	}
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -130,6 +132,7 @@ func TestTraces_AreCorrectlySplitPerResourceAttributeRouting(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 
 	tr := ptrace.NewTraces()
 
@@ -179,7 +182,7 @@ func TestTraces_RoutingWorks_Context(t *testing.T) {
 		},
 	}
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  contextAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -190,6 +193,7 @@ func TestTraces_RoutingWorks_Context(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	tr := ptrace.NewTraces()
@@ -243,7 +247,7 @@ func TestTraces_RoutingWorks_ResourceAttribute(t *testing.T) {
 		},
 	}
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		FromAttribute:    "X-Tenant",
 		AttributeSource:  resourceAttributeSource,
 		DefaultExporters: []string{"otlp"},
@@ -254,6 +258,7 @@ func TestTraces_RoutingWorks_ResourceAttribute(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	t.Run("non default route is properly used", func(t *testing.T) {
@@ -301,7 +306,7 @@ func TestTraces_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing.
 		},
 	}
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		AttributeSource:              resourceAttributeSource,
 		FromAttribute:                "X-Tenant",
 		DropRoutingResourceAttribute: true,
@@ -313,6 +318,7 @@ func TestTraces_RoutingWorks_ResourceAttribute_DropsRoutingAttribute(t *testing.
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	tr := ptrace.NewTraces()
@@ -352,7 +358,7 @@ func TestTracesAreCorrectlySplitPerResourceAttributeWithOTTL(t *testing.T) {
 		},
 	}
 
-	exp := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
+	exp, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, &Config{
 		DefaultExporters: []string{"otlp"},
 		Table: []RoutingTableItem{
 			{
@@ -365,6 +371,7 @@ func TestTracesAreCorrectlySplitPerResourceAttributeWithOTTL(t *testing.T) {
 			},
 		},
 	})
+	require.NoError(t, err)
 	require.NoError(t, exp.Start(context.Background(), host))
 
 	t.Run("span by matched no expressions", func(t *testing.T) {
@@ -472,7 +479,8 @@ func TestTraceProcessorCapabilities(t *testing.T) {
 	}
 
 	// test
-	p := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, config)
+	p, err := newTracesProcessor(component.TelemetrySettings{Logger: zap.NewNop()}, config)
+	require.NoError(t, err)
 	require.NotNil(t, p)
 
 	// verify
@@ -49,22 +49,25 @@ var _ config.Processor = (*Config)(nil)
 func (c *Config) Validate() error {
 	var errors error
 
-	ottltracesp := ottltraces.NewParser(traces.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err := ottltracesp.ParseStatements(c.Traces.Statements)
+	ottltracesp, err := ottltraces.NewParser(traces.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
 	if err != nil {
 		errors = multierr.Append(errors, err)
+	} else if _, err = ottltracesp.ParseStatements(c.Traces.Statements); err != nil {
+		errors = multierr.Append(errors, err)
 	}
 
-	ottlmetricsp := ottldatapoints.NewParser(metrics.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err = ottlmetricsp.ParseStatements(c.Metrics.Statements)
+	ottlmetricsp, err := ottldatapoints.NewParser(metrics.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
 	if err != nil {
 		errors = multierr.Append(errors, err)
+	} else if _, err = ottlmetricsp.ParseStatements(c.Metrics.Statements); err != nil {
+		errors = multierr.Append(errors, err)
 	}
 
-	ottllogsp := ottllogs.NewParser(logs.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
-	_, err = ottllogsp.ParseStatements(c.Logs.Statements)
+	ottllogsp, err := ottllogs.NewParser(logs.Functions(), component.TelemetrySettings{Logger: zap.NewNop()})
 	if err != nil {
 		errors = multierr.Append(errors, err)
+	} else if _, err = ottllogsp.ParseStatements(c.Logs.Statements); err != nil {
+		errors = multierr.Append(errors, err)
 	}
 	return errors
 }
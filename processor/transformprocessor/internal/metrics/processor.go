@@ -30,7 +30,10 @@ type Processor struct {
 }
 
 func NewProcessor(statements []string, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
-	ottlp := ottldatapoints.NewParser(functions, settings)
+	ottlp, err := ottldatapoints.NewParser(functions, settings)
+	if err != nil {
+		return nil, err
+	}
 	parsedStatements, err := ottlp.ParseStatements(statements)
 	if err != nil {
 		return nil, err
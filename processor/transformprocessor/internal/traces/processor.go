@@ -29,7 +29,10 @@ type Processor struct {
 }
 
 func NewProcessor(statements []string, functions map[string]interface{}, settings component.TelemetrySettings) (*Processor, error) {
-	ottlp := ottltraces.NewParser(functions, settings)
+	ottlp, err := ottltraces.NewParser(functions, settings)
+	if err != nil {
+		return nil, err
+	}
 	parsedStatements, err := ottlp.ParseStatements(statements)
 	if err != nil {
 		return nil, err
@@ -13,8 +13,47 @@
 // limitations under the License.
 
 package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
-import "go.opentelemetry.io/collector/config"
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
+)
 
 type Config struct {
-	config.ReceiverSettings `mapstructure:",squash"` // squash ensures fields are correctly decoded in embedded struct
+	DataSource                              string `mapstructure:"datasource"`
+	scraperhelper.ScraperControllerSettings `mapstructure:",squash"`
+	metadata.MetricsSettings                `mapstructure:"metrics"`
+	// TopWaitClasses limits the wait event metrics to the events of the given number of wait classes
+	// with the most time waited. All wait classes are reported if it's 0.
+	TopWaitClasses int `mapstructure:"top_wait_classes"`
+	// LogQueries are the queries whose rows are emitted as log records when the receiver is used
+	// in a logs pipeline. They are ignored by metrics pipelines.
+	LogQueries []LogQuery `mapstructure:"log_queries"`
+}
+
+// LogQuery is a SQL query emitting one log record per row, with the columns as attributes.
+type LogQuery struct {
+	SQL string `mapstructure:"sql"`
+	// BodyColumn is the column used as the body of the log records. It isn't added to the
+	// attributes. The body is empty if it isn't set or isn't a column of the results.
+	BodyColumn string `mapstructure:"body_column"`
+}
+
+func (c Config) Validate() error {
+	if c.DataSource == "" {
+		return errors.New("'datasource' cannot be empty")
+	}
+	if c.TopWaitClasses < 0 {
+		return errors.New("'top_wait_classes' cannot be negative")
+	}
+	for i, query := range c.LogQueries {
+		if query.SQL == "" {
+			return fmt.Errorf("'log_queries[%d].sql' cannot be empty", i)
+		}
+	}
+	return nil
 }
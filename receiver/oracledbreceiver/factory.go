@@ -16,10 +16,18 @@ package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-coll
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"net/url"
+	"reflect"
+	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/config"
 	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
 )
 
 const (
@@ -32,13 +40,100 @@ func NewFactory() component.ReceiverFactory {
 	return component.NewReceiverFactory(
 		typeStr,
 		createDefaultConfig,
-		component.WithMetricsReceiver(createMetricsReceiver, stability))
+		component.WithMetricsReceiver(createReceiverFunc(sql.Open, newDbClient), stability),
+		component.WithLogsReceiver(createLogsReceiverFunc(sql.Open, newDbClient), stability))
 }
 
-func createMetricsReceiver(ctx context.Context, settings component.ReceiverCreateSettings, receiver config.Receiver, metrics consumer.Metrics) (component.MetricsReceiver, error) {
-	return &oracledbreceiver{}, nil
+func createDefaultConfig() config.Receiver {
+	return &Config{
+		ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+			ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+			CollectionInterval: 10 * time.Second,
+		},
+		MetricsSettings: metadata.DefaultMetricsSettings(),
+	}
 }
 
-func createDefaultConfig() config.Receiver {
-	return &Config{ReceiverSettings: config.NewReceiverSettings(config.NewComponentID(typeStr))}
+type sqlOpenerFunc func(driverName, dataSourceName string) (*sql.DB, error)
+
+func createReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientProviderFunc) component.CreateMetricsReceiverFunc {
+	return func(
+		ctx context.Context,
+		settings component.ReceiverCreateSettings,
+		cfg config.Receiver,
+		consumer consumer.Metrics,
+	) (component.MetricsReceiver, error) {
+		sqlCfg := cfg.(*Config)
+		if !metricsEnabled(sqlCfg.MetricsSettings) {
+			settings.Logger.Warn("all metrics are disabled in the configuration, the receiver won't emit any metrics")
+		}
+		metricsBuilder := metadata.NewMetricsBuilder(sqlCfg.MetricsSettings, settings.BuildInfo)
+
+		mp, err := newScraper(metricsBuilder, sqlCfg.ScraperControllerSettings, settings.TelemetrySettings.Logger, func(ctx context.Context) (*sql.DB, error) {
+			return openDB(ctx, sqlOpenerFunc, sqlCfg.DataSource)
+		}, clientProviderFunc, sqlCfg.TopWaitClasses, endpointFromDataSource(sqlCfg.DataSource))
+		if err != nil {
+			return nil, err
+		}
+		opt := scraperhelper.AddScraper(mp)
+
+		return scraperhelper.NewScraperControllerReceiver(
+			&sqlCfg.ScraperControllerSettings,
+			settings,
+			consumer,
+			opt,
+		)
+	}
+}
+
+func createLogsReceiverFunc(sqlOpenerFunc sqlOpenerFunc, clientProviderFunc clientProviderFunc) component.CreateLogsReceiverFunc {
+	return func(
+		ctx context.Context,
+		settings component.ReceiverCreateSettings,
+		cfg config.Receiver,
+		consumer consumer.Logs,
+	) (component.LogsReceiver, error) {
+		sqlCfg := cfg.(*Config)
+		if len(sqlCfg.LogQueries) == 0 {
+			return nil, errors.New("'log_queries' cannot be empty in a logs pipeline")
+		}
+
+		return newLogsReceiver(sqlCfg.LogQueries, sqlCfg.CollectionInterval, consumer, settings.TelemetrySettings.Logger, func(ctx context.Context) (*sql.DB, error) {
+			return openDB(ctx, sqlOpenerFunc, sqlCfg.DataSource)
+		}, clientProviderFunc), nil
+	}
+}
+
+// metricsEnabled returns true if at least one metric is enabled in the settings.
+func metricsEnabled(settings metadata.MetricsSettings) bool {
+	v := reflect.ValueOf(settings)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).FieldByName("Enabled").Bool() {
+			return true
+		}
+	}
+	return false
+}
+
+// openDB opens a connection pool to the database and verifies that the database is reachable.
+func openDB(ctx context.Context, sqlOpenerFunc sqlOpenerFunc, dataSource string) (*sql.DB, error) {
+	db, err := sqlOpenerFunc("oracle", dataSource)
+	if err != nil {
+		return nil, err
+	}
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// endpointFromDataSource returns the host, port and service of the data source without the
+// credentials, or the data source itself if it isn't a URL.
+func endpointFromDataSource(dataSource string) string {
+	u, err := url.Parse(dataSource)
+	if err != nil || u.Host == "" {
+		return dataSource
+	}
+	return u.Host + u.Path
 }
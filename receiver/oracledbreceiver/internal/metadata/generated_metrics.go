@@ -3,6 +3,8 @@
 package metadata
 
 import (
+	"fmt"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
@@ -49,6 +51,8 @@ type MetricsSettings struct {
 	OracledbExecutions            MetricSettings `mapstructure:"oracledb.executions"`
 	OracledbHardParses            MetricSettings `mapstructure:"oracledb.hard_parses"`
 	OracledbLogicalReads          MetricSettings `mapstructure:"oracledb.logical_reads"`
+	OracledbMemoryPga             MetricSettings `mapstructure:"oracledb.memory.pga"`
+	OracledbMemorySga             MetricSettings `mapstructure:"oracledb.memory.sga"`
 	OracledbParseCalls            MetricSettings `mapstructure:"oracledb.parse_calls"`
 	OracledbPgaMemory             MetricSettings `mapstructure:"oracledb.pga_memory"`
 	OracledbPhysicalReads         MetricSettings `mapstructure:"oracledb.physical_reads"`
@@ -62,6 +66,8 @@ type MetricsSettings struct {
 	OracledbTransactionsUsage     MetricSettings `mapstructure:"oracledb.transactions.usage"`
 	OracledbUserCommits           MetricSettings `mapstructure:"oracledb.user_commits"`
 	OracledbUserRollbacks         MetricSettings `mapstructure:"oracledb.user_rollbacks"`
+	OracledbWaitCount             MetricSettings `mapstructure:"oracledb.wait.count"`
+	OracledbWaitTime              MetricSettings `mapstructure:"oracledb.wait.time"`
 }
 
 func DefaultMetricsSettings() MetricsSettings {
@@ -102,6 +108,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		OracledbLogicalReads: MetricSettings{
 			Enabled: true,
 		},
+		OracledbMemoryPga: MetricSettings{
+			Enabled: true,
+		},
+		OracledbMemorySga: MetricSettings{
+			Enabled: true,
+		},
 		OracledbParseCalls: MetricSettings{
 			Enabled: true,
 		},
@@ -141,6 +153,12 @@ func DefaultMetricsSettings() MetricsSettings {
 		OracledbUserRollbacks: MetricSettings{
 			Enabled: true,
 		},
+		OracledbWaitCount: MetricSettings{
+			Enabled: true,
+		},
+		OracledbWaitTime: MetricSettings{
+			Enabled: true,
+		},
 	}
 }
 
@@ -744,6 +762,108 @@ func newMetricOracledbLogicalReads(settings MetricSettings) metricOracledbLogica
 	return m
 }
 
+type metricOracledbMemoryPga struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.memory.pga metric with initial data.
+func (m *metricOracledbMemoryPga) init() {
+	m.data.SetName("oracledb.memory.pga")
+	m.data.SetDescription("PGA (Program Global Area) memory statistics of the instance, in bytes.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbMemoryPga) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, nameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", nameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbMemoryPga) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbMemoryPga) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbMemoryPga(settings MetricSettings) metricOracledbMemoryPga {
+	m := metricOracledbMemoryPga{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricOracledbMemorySga struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.memory.sga metric with initial data.
+func (m *metricOracledbMemorySga) init() {
+	m.data.SetName("oracledb.memory.sga")
+	m.data.SetDescription("SGA (System Global Area) memory components of the instance, in bytes.")
+	m.data.SetUnit("By")
+	m.data.SetEmptyGauge()
+	m.data.Gauge().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbMemorySga) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, nameAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Gauge().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("name", nameAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbMemorySga) updateCapacity() {
+	if m.data.Gauge().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Gauge().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbMemorySga) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Gauge().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbMemorySga(settings MetricSettings) metricOracledbMemorySga {
+	m := metricOracledbMemorySga{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 type metricOracledbParseCalls struct {
 	data     pmetric.Metric // data buffer for generated metric.
 	settings MetricSettings // metric settings provided by user.
@@ -1398,6 +1518,114 @@ func newMetricOracledbUserRollbacks(settings MetricSettings) metricOracledbUserR
 	return m
 }
 
+type metricOracledbWaitCount struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.wait.count metric with initial data.
+func (m *metricOracledbWaitCount) init() {
+	m.data.SetName("oracledb.wait.count")
+	m.data.SetDescription("Total number of waits for the event")
+	m.data.SetUnit("{waits}")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbWaitCount) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val int64, waitClassAttributeValue string, eventAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetIntValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbWaitCount) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbWaitCount) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbWaitCount(settings MetricSettings) metricOracledbWaitCount {
+	m := metricOracledbWaitCount{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
+type metricOracledbWaitTime struct {
+	data     pmetric.Metric // data buffer for generated metric.
+	settings MetricSettings // metric settings provided by user.
+	capacity int            // max observed number of data points added to the metric.
+}
+
+// init fills oracledb.wait.time metric with initial data.
+func (m *metricOracledbWaitTime) init() {
+	m.data.SetName("oracledb.wait.time")
+	m.data.SetDescription("Total amount of time waited for the event, in seconds")
+	m.data.SetUnit("s")
+	m.data.SetEmptySum()
+	m.data.Sum().SetIsMonotonic(true)
+	m.data.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+	m.data.Sum().DataPoints().EnsureCapacity(m.capacity)
+}
+
+func (m *metricOracledbWaitTime) recordDataPoint(start pcommon.Timestamp, ts pcommon.Timestamp, val float64, waitClassAttributeValue string, eventAttributeValue string) {
+	if !m.settings.Enabled {
+		return
+	}
+	dp := m.data.Sum().DataPoints().AppendEmpty()
+	dp.SetStartTimestamp(start)
+	dp.SetTimestamp(ts)
+	dp.SetDoubleValue(val)
+	dp.Attributes().PutStr("wait_class", waitClassAttributeValue)
+	dp.Attributes().PutStr("event", eventAttributeValue)
+}
+
+// updateCapacity saves max length of data point slices that will be used for the slice capacity.
+func (m *metricOracledbWaitTime) updateCapacity() {
+	if m.data.Sum().DataPoints().Len() > m.capacity {
+		m.capacity = m.data.Sum().DataPoints().Len()
+	}
+}
+
+// emit appends recorded metric data to a metrics slice and prepares it for recording another set of data points.
+func (m *metricOracledbWaitTime) emit(metrics pmetric.MetricSlice) {
+	if m.settings.Enabled && m.data.Sum().DataPoints().Len() > 0 {
+		m.updateCapacity()
+		m.data.MoveTo(metrics.AppendEmpty())
+		m.init()
+	}
+}
+
+func newMetricOracledbWaitTime(settings MetricSettings) metricOracledbWaitTime {
+	m := metricOracledbWaitTime{settings: settings}
+	if settings.Enabled {
+		m.data = pmetric.NewMetric()
+		m.init()
+	}
+	return m
+}
+
 // MetricsBuilder provides an interface for scrapers to report metrics while taking care of all the transformations
 // required to produce metric representation defined in metadata and user settings.
 type MetricsBuilder struct {
@@ -1418,6 +1646,8 @@ type MetricsBuilder struct {
 	metricOracledbExecutions            metricOracledbExecutions
 	metricOracledbHardParses            metricOracledbHardParses
 	metricOracledbLogicalReads          metricOracledbLogicalReads
+	metricOracledbMemoryPga             metricOracledbMemoryPga
+	metricOracledbMemorySga             metricOracledbMemorySga
 	metricOracledbParseCalls            metricOracledbParseCalls
 	metricOracledbPgaMemory             metricOracledbPgaMemory
 	metricOracledbPhysicalReads         metricOracledbPhysicalReads
@@ -1431,6 +1661,8 @@ type MetricsBuilder struct {
 	metricOracledbTransactionsUsage     metricOracledbTransactionsUsage
 	metricOracledbUserCommits           metricOracledbUserCommits
 	metricOracledbUserRollbacks         metricOracledbUserRollbacks
+	metricOracledbWaitCount             metricOracledbWaitCount
+	metricOracledbWaitTime              metricOracledbWaitTime
 }
 
 // metricBuilderOption applies changes to default metrics builder.
@@ -1460,6 +1692,8 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricOracledbExecutions:            newMetricOracledbExecutions(settings.OracledbExecutions),
 		metricOracledbHardParses:            newMetricOracledbHardParses(settings.OracledbHardParses),
 		metricOracledbLogicalReads:          newMetricOracledbLogicalReads(settings.OracledbLogicalReads),
+		metricOracledbMemoryPga:             newMetricOracledbMemoryPga(settings.OracledbMemoryPga),
+		metricOracledbMemorySga:             newMetricOracledbMemorySga(settings.OracledbMemorySga),
 		metricOracledbParseCalls:            newMetricOracledbParseCalls(settings.OracledbParseCalls),
 		metricOracledbPgaMemory:             newMetricOracledbPgaMemory(settings.OracledbPgaMemory),
 		metricOracledbPhysicalReads:         newMetricOracledbPhysicalReads(settings.OracledbPhysicalReads),
@@ -1473,6 +1707,8 @@ func NewMetricsBuilder(settings MetricsSettings, buildInfo component.BuildInfo,
 		metricOracledbTransactionsUsage:     newMetricOracledbTransactionsUsage(settings.OracledbTransactionsUsage),
 		metricOracledbUserCommits:           newMetricOracledbUserCommits(settings.OracledbUserCommits),
 		metricOracledbUserRollbacks:         newMetricOracledbUserRollbacks(settings.OracledbUserRollbacks),
+		metricOracledbWaitCount:             newMetricOracledbWaitCount(settings.OracledbWaitCount),
+		metricOracledbWaitTime:              newMetricOracledbWaitTime(settings.OracledbWaitTime),
 	}
 	for _, op := range options {
 		op(mb)
@@ -1493,6 +1729,13 @@ func (mb *MetricsBuilder) updateCapacity(rm pmetric.ResourceMetrics) {
 // ResourceMetricsOption applies changes to provided resource metrics.
 type ResourceMetricsOption func(pmetric.ResourceMetrics)
 
+// WithHostName sets provided value as "host.name" attribute for current resource.
+func WithHostName(val string) ResourceMetricsOption {
+	return func(rm pmetric.ResourceMetrics) {
+		rm.Resource().Attributes().PutStr("host.name", val)
+	}
+}
+
 // WithOracledbInstanceName sets provided value as "oracledb.instance.name" attribute for current resource.
 func WithOracledbInstanceName(val string) ResourceMetricsOption {
 	return func(rm pmetric.ResourceMetrics) {
@@ -1544,6 +1787,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricOracledbExecutions.emit(ils.Metrics())
 	mb.metricOracledbHardParses.emit(ils.Metrics())
 	mb.metricOracledbLogicalReads.emit(ils.Metrics())
+	mb.metricOracledbMemoryPga.emit(ils.Metrics())
+	mb.metricOracledbMemorySga.emit(ils.Metrics())
 	mb.metricOracledbParseCalls.emit(ils.Metrics())
 	mb.metricOracledbPgaMemory.emit(ils.Metrics())
 	mb.metricOracledbPhysicalReads.emit(ils.Metrics())
@@ -1557,6 +1802,8 @@ func (mb *MetricsBuilder) EmitForResource(rmo ...ResourceMetricsOption) {
 	mb.metricOracledbTransactionsUsage.emit(ils.Metrics())
 	mb.metricOracledbUserCommits.emit(ils.Metrics())
 	mb.metricOracledbUserRollbacks.emit(ils.Metrics())
+	mb.metricOracledbWaitCount.emit(ils.Metrics())
+	mb.metricOracledbWaitTime.emit(ils.Metrics())
 	for _, op := range rmo {
 		op(rm)
 	}
@@ -1582,123 +1829,278 @@ func (mb *MetricsBuilder) RecordOracledbCPUTimeDataPoint(ts pcommon.Timestamp, v
 }
 
 // RecordOracledbDmlLocksLimitDataPoint adds a data point to oracledb.dml_locks.limit metric.
-func (mb *MetricsBuilder) RecordOracledbDmlLocksLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbDmlLocksLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbDmlLocksLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbDmlLocksLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbDmlLocksUsageDataPoint adds a data point to oracledb.dml_locks.usage metric.
-func (mb *MetricsBuilder) RecordOracledbDmlLocksUsageDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbDmlLocksUsageDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbDmlLocksUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbDmlLocksUsage.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbEnqueueDeadlocksDataPoint adds a data point to oracledb.enqueue_deadlocks metric.
-func (mb *MetricsBuilder) RecordOracledbEnqueueDeadlocksDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbEnqueueDeadlocksDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbEnqueueDeadlocks, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbEnqueueDeadlocks.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbEnqueueLocksLimitDataPoint adds a data point to oracledb.enqueue_locks.limit metric.
-func (mb *MetricsBuilder) RecordOracledbEnqueueLocksLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbEnqueueLocksLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbEnqueueLocksLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbEnqueueLocksLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbEnqueueLocksUsageDataPoint adds a data point to oracledb.enqueue_locks.usage metric.
-func (mb *MetricsBuilder) RecordOracledbEnqueueLocksUsageDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbEnqueueLocksUsageDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbEnqueueLocksUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbEnqueueLocksUsage.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbEnqueueResourcesLimitDataPoint adds a data point to oracledb.enqueue_resources.limit metric.
-func (mb *MetricsBuilder) RecordOracledbEnqueueResourcesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbEnqueueResourcesLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbEnqueueResourcesLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbEnqueueResourcesLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbEnqueueResourcesUsageDataPoint adds a data point to oracledb.enqueue_resources.usage metric.
-func (mb *MetricsBuilder) RecordOracledbEnqueueResourcesUsageDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbEnqueueResourcesUsageDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbEnqueueResourcesUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbEnqueueResourcesUsage.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbExchangeDeadlocksDataPoint adds a data point to oracledb.exchange_deadlocks metric.
-func (mb *MetricsBuilder) RecordOracledbExchangeDeadlocksDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbExchangeDeadlocksDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbExchangeDeadlocks, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbExchangeDeadlocks.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbExecutionsDataPoint adds a data point to oracledb.executions metric.
-func (mb *MetricsBuilder) RecordOracledbExecutionsDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbExecutionsDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbExecutions, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbExecutions.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbHardParsesDataPoint adds a data point to oracledb.hard_parses metric.
-func (mb *MetricsBuilder) RecordOracledbHardParsesDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbHardParsesDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbHardParses, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbHardParses.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbLogicalReadsDataPoint adds a data point to oracledb.logical_reads metric.
-func (mb *MetricsBuilder) RecordOracledbLogicalReadsDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbLogicalReadsDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbLogicalReads, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbLogicalReads.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordOracledbMemoryPgaDataPoint adds a data point to oracledb.memory.pga metric.
+func (mb *MetricsBuilder) RecordOracledbMemoryPgaDataPoint(ts pcommon.Timestamp, inputVal string, nameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbMemoryPga, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbMemoryPga.recordDataPoint(mb.startTime, ts, val, nameAttributeValue)
+	return nil
+}
+
+// RecordOracledbMemorySgaDataPoint adds a data point to oracledb.memory.sga metric.
+func (mb *MetricsBuilder) RecordOracledbMemorySgaDataPoint(ts pcommon.Timestamp, inputVal string, nameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbMemorySga, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbMemorySga.recordDataPoint(mb.startTime, ts, val, nameAttributeValue)
+	return nil
 }
 
 // RecordOracledbParseCallsDataPoint adds a data point to oracledb.parse_calls metric.
-func (mb *MetricsBuilder) RecordOracledbParseCallsDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbParseCallsDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbParseCalls, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbParseCalls.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbPgaMemoryDataPoint adds a data point to oracledb.pga_memory metric.
-func (mb *MetricsBuilder) RecordOracledbPgaMemoryDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbPgaMemoryDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbPgaMemory, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbPgaMemory.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbPhysicalReadsDataPoint adds a data point to oracledb.physical_reads metric.
-func (mb *MetricsBuilder) RecordOracledbPhysicalReadsDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbPhysicalReadsDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbPhysicalReads, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbPhysicalReads.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbProcessesLimitDataPoint adds a data point to oracledb.processes.limit metric.
-func (mb *MetricsBuilder) RecordOracledbProcessesLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbProcessesLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbProcessesLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbProcessesLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbProcessesUsageDataPoint adds a data point to oracledb.processes.usage metric.
-func (mb *MetricsBuilder) RecordOracledbProcessesUsageDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbProcessesUsageDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbProcessesUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbProcessesUsage.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbSessionsLimitDataPoint adds a data point to oracledb.sessions.limit metric.
-func (mb *MetricsBuilder) RecordOracledbSessionsLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbSessionsLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbSessionsLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbSessionsLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbSessionsUsageDataPoint adds a data point to oracledb.sessions.usage metric.
-func (mb *MetricsBuilder) RecordOracledbSessionsUsageDataPoint(ts pcommon.Timestamp, val int64, sessionTypeAttributeValue string, sessionStatusAttributeValue string) {
+func (mb *MetricsBuilder) RecordOracledbSessionsUsageDataPoint(ts pcommon.Timestamp, inputVal string, sessionTypeAttributeValue string, sessionStatusAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbSessionsUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbSessionsUsage.recordDataPoint(mb.startTime, ts, val, sessionTypeAttributeValue, sessionStatusAttributeValue)
+	return nil
 }
 
 // RecordOracledbTablespaceSizeLimitDataPoint adds a data point to oracledb.tablespace_size.limit metric.
-func (mb *MetricsBuilder) RecordOracledbTablespaceSizeLimitDataPoint(ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
+func (mb *MetricsBuilder) RecordOracledbTablespaceSizeLimitDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTablespaceSizeLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbTablespaceSizeLimit.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
+	return nil
 }
 
 // RecordOracledbTablespaceSizeUsageDataPoint adds a data point to oracledb.tablespace_size.usage metric.
-func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsageDataPoint(ts pcommon.Timestamp, val int64, tablespaceNameAttributeValue string) {
+func (mb *MetricsBuilder) RecordOracledbTablespaceSizeUsageDataPoint(ts pcommon.Timestamp, inputVal string, tablespaceNameAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTablespaceSizeUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbTablespaceSizeUsage.recordDataPoint(mb.startTime, ts, val, tablespaceNameAttributeValue)
+	return nil
 }
 
 // RecordOracledbTransactionsLimitDataPoint adds a data point to oracledb.transactions.limit metric.
-func (mb *MetricsBuilder) RecordOracledbTransactionsLimitDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbTransactionsLimitDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTransactionsLimit, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbTransactionsLimit.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbTransactionsUsageDataPoint adds a data point to oracledb.transactions.usage metric.
-func (mb *MetricsBuilder) RecordOracledbTransactionsUsageDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbTransactionsUsageDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbTransactionsUsage, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbTransactionsUsage.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbUserCommitsDataPoint adds a data point to oracledb.user_commits metric.
-func (mb *MetricsBuilder) RecordOracledbUserCommitsDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbUserCommitsDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbUserCommits, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbUserCommits.recordDataPoint(mb.startTime, ts, val)
+	return nil
 }
 
 // RecordOracledbUserRollbacksDataPoint adds a data point to oracledb.user_rollbacks metric.
-func (mb *MetricsBuilder) RecordOracledbUserRollbacksDataPoint(ts pcommon.Timestamp, val int64) {
+func (mb *MetricsBuilder) RecordOracledbUserRollbacksDataPoint(ts pcommon.Timestamp, inputVal string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbUserRollbacks, value was %s: %w", inputVal, err)
+	}
 	mb.metricOracledbUserRollbacks.recordDataPoint(mb.startTime, ts, val)
+	return nil
+}
+
+// RecordOracledbWaitCountDataPoint adds a data point to oracledb.wait.count metric.
+func (mb *MetricsBuilder) RecordOracledbWaitCountDataPoint(ts pcommon.Timestamp, inputVal string, waitClassAttributeValue string, eventAttributeValue string) error {
+	val, err := strconv.ParseInt(inputVal, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse int64 for OracledbWaitCount, value was %s: %w", inputVal, err)
+	}
+	mb.metricOracledbWaitCount.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue, eventAttributeValue)
+	return nil
+}
+
+// RecordOracledbWaitTimeDataPoint adds a data point to oracledb.wait.time metric.
+func (mb *MetricsBuilder) RecordOracledbWaitTimeDataPoint(ts pcommon.Timestamp, val float64, waitClassAttributeValue string, eventAttributeValue string) {
+	mb.metricOracledbWaitTime.recordDataPoint(mb.startTime, ts, val, waitClassAttributeValue, eventAttributeValue)
 }
 
 // Reset resets metrics builder to its initial state. It should be used when external metrics source is restarted,
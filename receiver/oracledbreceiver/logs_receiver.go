@@ -0,0 +1,167 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+// logsReceiver periodically runs the log queries and emits their rows as log records.
+type logsReceiver struct {
+	queries            []LogQuery
+	interval           time.Duration
+	consumer           consumer.Logs
+	dbProviderFunc     dbProviderFunc
+	clientProviderFunc clientProviderFunc
+	logger             *zap.Logger
+
+	db      *sql.DB
+	clients []dbClient
+	// bodyColumnChecked tells, per query, whether the presence of the body column in its results was checked.
+	bodyColumnChecked []bool
+	cancel            context.CancelFunc
+	done              chan struct{}
+}
+
+func newLogsReceiver(queries []LogQuery, interval time.Duration, consumer consumer.Logs, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc) *logsReceiver {
+	return &logsReceiver{
+		queries:            queries,
+		interval:           interval,
+		consumer:           consumer,
+		dbProviderFunc:     providerFunc,
+		clientProviderFunc: clientProviderFunc,
+		logger:             logger,
+	}
+}
+
+// Start doesn't require the database to be reachable, the connection is established by the first
+// collection and retried on the next ones if it fails.
+func (r *logsReceiver) Start(_ context.Context, _ component.Host) error {
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	r.done = make(chan struct{})
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				r.collect(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (r *logsReceiver) Shutdown(context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+	<-r.done
+	if r.db == nil {
+		return nil
+	}
+	return r.db.Close()
+}
+
+func (r *logsReceiver) collect(ctx context.Context) {
+	logs, err := r.scrape(ctx)
+	if err != nil {
+		r.logger.Error("failed to query log records", zap.Error(err))
+	}
+	if logs.LogRecordCount() == 0 {
+		return
+	}
+	if err = r.consumer.ConsumeLogs(ctx, logs); err != nil {
+		r.logger.Error("failed to consume log records", zap.Error(err))
+	}
+}
+
+// scrape runs the log queries and converts each row to a log record. The log records of the
+// queries that succeed are returned along with the errors of the others.
+func (r *logsReceiver) scrape(ctx context.Context) (plog.Logs, error) {
+	if r.db == nil {
+		db, err := r.dbProviderFunc(ctx)
+		if err != nil {
+			return plog.NewLogs(), fmt.Errorf("failed to open db connection: %w", err)
+		}
+		r.db = db
+		r.clients = make([]dbClient, len(r.queries))
+		r.bodyColumnChecked = make([]bool, len(r.queries))
+		for i, query := range r.queries {
+			r.clients[i] = r.clientProviderFunc(db, query.SQL, r.logger)
+		}
+	}
+
+	logs := plog.NewLogs()
+	records := logs.ResourceLogs().AppendEmpty().ScopeLogs().AppendEmpty().LogRecords()
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs []error
+	for i, query := range r.queries {
+		rows, err := r.clients[i].metricRows(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error executing %s: %w", query.SQL, err))
+			continue
+		}
+		r.checkBodyColumn(i, rows)
+		for _, row := range rows {
+			record := records.AppendEmpty()
+			record.SetObservedTimestamp(now)
+			columns := make([]string, 0, len(row))
+			for column := range row {
+				columns = append(columns, column)
+			}
+			// sort the columns so the attributes have a deterministic order
+			sort.Strings(columns)
+			for _, column := range columns {
+				if column == query.BodyColumn {
+					record.Body().SetStr(row[column])
+					continue
+				}
+				record.Attributes().PutStr(column, row[column])
+			}
+		}
+	}
+	return logs, multierr.Combine(errs...)
+}
+
+// checkBodyColumn warns once per query if its body column isn't one of the columns of its results, in
+// which case the log records have an empty body. The columns are only known once the query returns rows.
+func (r *logsReceiver) checkBodyColumn(i int, rows []metricRow) {
+	query := r.queries[i]
+	if r.bodyColumnChecked[i] || query.BodyColumn == "" || len(rows) == 0 {
+		return
+	}
+	r.bodyColumnChecked[i] = true
+	if _, ok := rows[0][query.BodyColumn]; !ok {
+		r.logger.Warn("the body column isn't in the results of the log query, the log records have an empty body",
+			zap.String("query", query.SQL), zap.String("body_column", query.BodyColumn))
+	}
+}
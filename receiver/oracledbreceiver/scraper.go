@@ -0,0 +1,443 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracledbreceiver // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver"
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
+)
+
+const (
+	statsSQL                = "select * from v$sysstat"
+	enqueueDeadlocks        = "enqueue deadlocks"
+	exchangeDeadlocks       = "exchange deadlocks"
+	executeCount            = "execute count"
+	parseCountTotal         = "parse count (total)"
+	parseCountHard          = "parse count (hard)"
+	userCommits             = "user commits"
+	userRollbacks           = "user rollbacks"
+	physicalReads           = "physical reads"
+	sessionLogicalReads     = "session logical reads"
+	cpuTime                 = "CPU used by this session"
+	pgaMemory               = "session pga memory"
+	sessionCountSQL         = "select status, type, count(*) as VALUE FROM v$session GROUP BY status, type"
+	systemResourceLimitsSQL = "select RESOURCE_NAME, CURRENT_UTILIZATION, LIMIT_VALUE, CASE WHEN TRIM(INITIAL_ALLOCATION) LIKE 'UNLIMITED' THEN '-1' ELSE TRIM(INITIAL_ALLOCATION) END as INITIAL_ALLOCATION, CASE WHEN TRIM(LIMIT_VALUE) LIKE 'UNLIMITED' THEN '-1' ELSE TRIM(LIMIT_VALUE) END as LIMIT_VALUE from v$resource_limit"
+	tablespaceUsageSQL      = "select TABLESPACE_NAME, BYTES from DBA_DATA_FILES"
+	tablespaceMaxSpaceSQL   = "select TABLESPACE_NAME, (BLOCK_SIZE*MAX_EXTENTS) AS VALUE FROM DBA_TABLESPACES"
+	instanceSQL             = "select INSTANCE_NAME, HOST_NAME from v$instance"
+	waitEventsSQL           = "select WAIT_CLASS, EVENT, TOTAL_WAITS, TIME_WAITED_MICRO from v$system_event where WAIT_CLASS <> 'Idle'"
+	sgaMemorySQL            = "select NAME, BYTES from v$sgainfo"
+	pgaMemorySQL            = "select NAME, VALUE from v$pgastat where UNIT = 'bytes'"
+
+	// initialConnectBackoff is the delay before the first reconnection attempt after a failed connection.
+	initialConnectBackoff = time.Second
+)
+
+// errNotConnected is returned by scrapes that happen while waiting for the next connection attempt.
+var errNotConnected = errors.New("not connected to the database")
+
+type dbProviderFunc func(ctx context.Context) (*sql.DB, error)
+
+type clientProviderFunc func(*sql.DB, string, *zap.Logger) dbClient
+
+type scraper struct {
+	statsClient                dbClient
+	tablespaceMaxSpaceClient   dbClient
+	tablespaceUsageClient      dbClient
+	systemResourceLimitsClient dbClient
+	sessionCountClient         dbClient
+	waitEventsClient           dbClient
+	sgaMemoryClient            dbClient
+	pgaMemoryClient            dbClient
+	db                         *sql.DB
+	clientProviderFunc         clientProviderFunc
+	metricsBuilder             *metadata.MetricsBuilder
+	dbProviderFunc             dbProviderFunc
+	logger                     *zap.Logger
+	scrapeCfg                  scraperhelper.ScraperControllerSettings
+	now                        func() time.Time
+	topWaitClasses             int
+	// endpoint is the instance name reported if it can't be queried from the database.
+	endpoint     string
+	instanceName string
+	hostName     string
+
+	// connectBackoff is the current delay between connection attempts, capped at the collection interval.
+	connectBackoff time.Duration
+	// nextConnectAttempt is the earliest time at which the next connection attempt is made.
+	nextConnectAttempt time.Time
+}
+
+func newScraper(metricsBuilder *metadata.MetricsBuilder, scrapeCfg scraperhelper.ScraperControllerSettings, logger *zap.Logger, providerFunc dbProviderFunc, clientProviderFunc clientProviderFunc, topWaitClasses int, endpoint string) (scraperhelper.Scraper, error) {
+	s := &scraper{
+		metricsBuilder:     metricsBuilder,
+		scrapeCfg:          scrapeCfg,
+		logger:             logger,
+		dbProviderFunc:     providerFunc,
+		clientProviderFunc: clientProviderFunc,
+		now:                time.Now,
+		topWaitClasses:     topWaitClasses,
+		endpoint:           endpoint,
+	}
+	return scraperhelper.NewScraper(typeStr, s.scrape, scraperhelper.WithShutdown(s.shutdown), scraperhelper.WithStart(s.start))
+}
+
+// start doesn't require the database to be reachable. The connection is established lazily by the
+// first scrape, so the receiver keeps retrying if the database is down when the collector starts.
+func (s *scraper) start(ctx context.Context, _ component.Host) error {
+	if err := s.connect(ctx); err != nil {
+		s.logger.Warn("failed to connect to the database, retrying on the next scrapes", zap.Error(err))
+	}
+	return nil
+}
+
+// connect opens the connection to the database unless it's already open. Failed attempts are
+// retried with an exponential backoff, capped at the collection interval.
+func (s *scraper) connect(ctx context.Context) error {
+	if s.db != nil {
+		return nil
+	}
+
+	now := s.now()
+	if now.Before(s.nextConnectAttempt) {
+		return errNotConnected
+	}
+
+	db, err := s.dbProviderFunc(ctx)
+	if err != nil {
+		s.connectBackoff *= 2
+		if s.connectBackoff == 0 {
+			s.connectBackoff = initialConnectBackoff
+		}
+		if s.scrapeCfg.CollectionInterval > 0 && s.connectBackoff > s.scrapeCfg.CollectionInterval {
+			s.connectBackoff = s.scrapeCfg.CollectionInterval
+		}
+		s.nextConnectAttempt = now.Add(s.connectBackoff)
+		return fmt.Errorf("failed to open db connection: %w", err)
+	}
+
+	s.db = db
+	s.connectBackoff = 0
+	s.nextConnectAttempt = time.Time{}
+	s.statsClient = s.clientProviderFunc(s.db, statsSQL, s.logger)
+	s.sessionCountClient = s.clientProviderFunc(s.db, sessionCountSQL, s.logger)
+	s.systemResourceLimitsClient = s.clientProviderFunc(s.db, systemResourceLimitsSQL, s.logger)
+	s.tablespaceUsageClient = s.clientProviderFunc(s.db, tablespaceUsageSQL, s.logger)
+	s.tablespaceMaxSpaceClient = s.clientProviderFunc(s.db, tablespaceMaxSpaceSQL, s.logger)
+	s.waitEventsClient = s.clientProviderFunc(s.db, waitEventsSQL, s.logger)
+	s.sgaMemoryClient = s.clientProviderFunc(s.db, sgaMemorySQL, s.logger)
+	s.pgaMemoryClient = s.clientProviderFunc(s.db, pgaMemorySQL, s.logger)
+	s.queryInstance(ctx)
+	return nil
+}
+
+// queryInstance looks up the instance and host name, falling back to the configured endpoint if the
+// lookup fails.
+func (s *scraper) queryInstance(ctx context.Context) {
+	s.instanceName, s.hostName = s.endpoint, ""
+	rows, err := s.clientProviderFunc(s.db, instanceSQL, s.logger).metricRows(ctx)
+	if err != nil {
+		s.logger.Warn("failed to query the instance name, using the endpoint instead", zap.Error(err))
+		return
+	}
+	if len(rows) == 0 || rows[0]["INSTANCE_NAME"] == "" {
+		s.logger.Warn("no instance name returned, using the endpoint instead")
+		return
+	}
+	s.instanceName, s.hostName = rows[0]["INSTANCE_NAME"], rows[0]["HOST_NAME"]
+}
+
+func (s *scraper) scrape(ctx context.Context) (pmetric.Metrics, error) {
+	if err := s.connect(ctx); err != nil {
+		return pmetric.NewMetrics(), err
+	}
+
+	s.logger.Debug("Begin scrape")
+
+	var scrapeErrors []error
+
+	rows, execError := s.statsClient.metricRows(ctx)
+	if execError != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", statsSQL, execError))
+	}
+
+	for _, row := range rows {
+		switch row["NAME"] {
+		case enqueueDeadlocks:
+			err := s.metricsBuilder.RecordOracledbEnqueueDeadlocksDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case exchangeDeadlocks:
+			err := s.metricsBuilder.RecordOracledbExchangeDeadlocksDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case executeCount:
+			err := s.metricsBuilder.RecordOracledbExecutionsDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case parseCountTotal:
+			err := s.metricsBuilder.RecordOracledbParseCallsDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case parseCountHard:
+			err := s.metricsBuilder.RecordOracledbHardParsesDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case userCommits:
+			err := s.metricsBuilder.RecordOracledbUserCommitsDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case userRollbacks:
+			err := s.metricsBuilder.RecordOracledbUserRollbacksDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case physicalReads:
+			err := s.metricsBuilder.RecordOracledbPhysicalReadsDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case sessionLogicalReads:
+			err := s.metricsBuilder.RecordOracledbLogicalReadsDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case cpuTime:
+			value, err := strconv.ParseFloat(row["VALUE"], 64)
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, fmt.Errorf("%s value: %q, %w", cpuTime, row["VALUE"], err))
+			} else {
+				// divide by 100 as the value is expressed in tens of milliseconds
+				value /= 100
+				s.metricsBuilder.RecordOracledbCPUTimeDataPoint(pcommon.NewTimestampFromTime(time.Now()), value)
+			}
+		case pgaMemory:
+			err := s.metricsBuilder.RecordOracledbPgaMemoryDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"])
+			if err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+	}
+
+	rows, err := s.sessionCountClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", sessionCountSQL, err))
+	}
+	for _, row := range rows {
+		err := s.metricsBuilder.RecordOracledbSessionsUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"], row["TYPE"], row["STATUS"])
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
+	rows, err = s.systemResourceLimitsClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", systemResourceLimitsSQL, err))
+	}
+	for _, row := range rows {
+		resourceName := row["RESOURCE_NAME"]
+		switch resourceName {
+		case "processes":
+			if err := s.metricsBuilder.RecordOracledbProcessesUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["CURRENT_UTILIZATION"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+			if err := s.metricsBuilder.RecordOracledbProcessesLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case "sessions":
+			if err := s.metricsBuilder.RecordOracledbSessionsLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case "enqueue_locks":
+			if err := s.metricsBuilder.RecordOracledbEnqueueLocksUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["CURRENT_UTILIZATION"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+			if err := s.metricsBuilder.RecordOracledbEnqueueLocksLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case "dml_locks":
+			if err := s.metricsBuilder.RecordOracledbDmlLocksUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["CURRENT_UTILIZATION"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+			if err := s.metricsBuilder.RecordOracledbDmlLocksLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case "enqueue_resources":
+			if err := s.metricsBuilder.RecordOracledbEnqueueResourcesUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["CURRENT_UTILIZATION"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+			if err := s.metricsBuilder.RecordOracledbEnqueueResourcesLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		case "transactions":
+			if err := s.metricsBuilder.RecordOracledbTransactionsUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["CURRENT_UTILIZATION"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+			if err := s.metricsBuilder.RecordOracledbTransactionsLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["LIMIT_VALUE"]); err != nil {
+				scrapeErrors = append(scrapeErrors, err)
+			}
+		}
+	}
+
+	rows, err = s.tablespaceUsageClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", tablespaceUsageSQL, err))
+	}
+	for _, row := range rows {
+		err := s.metricsBuilder.RecordOracledbTablespaceSizeUsageDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["BYTES"], row["TABLESPACE_NAME"])
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
+	rows, err = s.tablespaceMaxSpaceClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", tablespaceMaxSpaceSQL, err))
+	}
+	for _, row := range rows {
+		// the value is empty for tablespaces without a limit
+		if row["VALUE"] == "" {
+			continue
+		}
+		err := s.metricsBuilder.RecordOracledbTablespaceSizeLimitDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"], row["TABLESPACE_NAME"])
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
+	rows, err = s.waitEventsClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", waitEventsSQL, err))
+	}
+	for _, row := range topWaitClassRows(rows, s.topWaitClasses) {
+		waitClass, event := row["WAIT_CLASS"], row["EVENT"]
+		if err := s.metricsBuilder.RecordOracledbWaitCountDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["TOTAL_WAITS"], waitClass, event); err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+		value, err := strconv.ParseFloat(row["TIME_WAITED_MICRO"], 64)
+		if err != nil {
+			scrapeErrors = append(scrapeErrors, fmt.Errorf("failed to parse float64 for OracledbWaitTime, value was %s: %w", row["TIME_WAITED_MICRO"], err))
+			continue
+		}
+		// the value is expressed in microseconds
+		s.metricsBuilder.RecordOracledbWaitTimeDataPoint(pcommon.NewTimestampFromTime(time.Now()), value/1e6, waitClass, event)
+	}
+
+	rows, err = s.sgaMemoryClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", sgaMemorySQL, err))
+	}
+	for _, row := range rows {
+		// the value is null for components that aren't allocated
+		if row["BYTES"] == "" {
+			continue
+		}
+		if err := s.metricsBuilder.RecordOracledbMemorySgaDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["BYTES"], row["NAME"]); err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
+	rows, err = s.pgaMemoryClient.metricRows(ctx)
+	if err != nil {
+		scrapeErrors = append(scrapeErrors, fmt.Errorf("error executing %s: %w", pgaMemorySQL, err))
+	}
+	for _, row := range rows {
+		if row["VALUE"] == "" {
+			continue
+		}
+		if err := s.metricsBuilder.RecordOracledbMemoryPgaDataPoint(pcommon.NewTimestampFromTime(time.Now()), row["VALUE"], row["NAME"]); err != nil {
+			scrapeErrors = append(scrapeErrors, err)
+		}
+	}
+
+	rmo := []metadata.ResourceMetricsOption{metadata.WithOracledbInstanceName(s.instanceName)}
+	if s.hostName != "" {
+		rmo = append(rmo, metadata.WithHostName(s.hostName))
+	}
+	out := s.metricsBuilder.Emit(rmo...)
+	s.logger.Debug("Done scraping")
+	if len(scrapeErrors) > 0 {
+		return out, multierr.Combine(scrapeErrors...)
+	}
+	return out, nil
+}
+
+func (s *scraper) shutdown(ctx context.Context) error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+// topWaitClassRows returns the wait event rows of the n wait classes with the most time waited.
+// All rows are returned if n is 0.
+func topWaitClassRows(rows []metricRow, n int) []metricRow {
+	if n <= 0 {
+		return rows
+	}
+
+	timeWaited := map[string]float64{}
+	for _, row := range rows {
+		// rows that can't be parsed are reported when recording them
+		value, _ := strconv.ParseFloat(row["TIME_WAITED_MICRO"], 64)
+		timeWaited[row["WAIT_CLASS"]] += value
+	}
+	if len(timeWaited) <= n {
+		return rows
+	}
+
+	classes := make([]string, 0, len(timeWaited))
+	for class := range timeWaited {
+		classes = append(classes, class)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		if timeWaited[classes[i]] != timeWaited[classes[j]] {
+			return timeWaited[classes[i]] > timeWaited[classes[j]]
+		}
+		return classes[i] < classes[j]
+	})
+	top := map[string]bool{}
+	for _, class := range classes[:n] {
+		top[class] = true
+	}
+
+	var out []metricRow
+	for _, row := range rows {
+		if top[row["WAIT_CLASS"]] {
+			out = append(out, row)
+		}
+	}
+	return out
+}
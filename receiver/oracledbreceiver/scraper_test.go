@@ -0,0 +1,339 @@
+// Copyright The OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oracledbreceiver
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/component/componenttest"
+	"go.opentelemetry.io/collector/receiver/scraperhelper"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/oracledbreceiver/internal/metadata"
+)
+
+type fakeDbClient struct {
+	Responses [][]metricRow
+	Err       error
+}
+
+func (c fakeDbClient) metricRows(context.Context) ([]metricRow, error) {
+	if c.Err != nil {
+		return nil, c.Err
+	}
+	if len(c.Responses) == 0 {
+		return nil, nil
+	}
+	return c.Responses[0], nil
+}
+
+var queryResponses = map[string][]metricRow{
+	statsSQL: {{"NAME": enqueueDeadlocks, "VALUE": "18"}, {"NAME": exchangeDeadlocks, "VALUE": "88898"},
+		{"NAME": executeCount, "VALUE": "178878"}, {"NAME": parseCountTotal, "VALUE": "1999"},
+		{"NAME": parseCountHard, "VALUE": "1"}, {"NAME": userCommits, "VALUE": "187778888"},
+		{"NAME": userRollbacks, "VALUE": "1898979879789"}, {"NAME": physicalReads, "VALUE": "1887777"},
+		{"NAME": sessionLogicalReads, "VALUE": "189"}, {"NAME": cpuTime, "VALUE": "1887"},
+		{"NAME": pgaMemory, "VALUE": "1999887"}},
+	sessionCountSQL:         {{"STATUS": "ACTIVE", "TYPE": "USER", "VALUE": "1"}},
+	systemResourceLimitsSQL: {{"RESOURCE_NAME": "processes", "CURRENT_UTILIZATION": "3", "LIMIT_VALUE": "10"}},
+	tablespaceUsageSQL:      {{"TABLESPACE_NAME": "SYS", "BYTES": "1024"}},
+	tablespaceMaxSpaceSQL:   {{"TABLESPACE_NAME": "SYS", "VALUE": "1024"}},
+	instanceSQL:             {{"INSTANCE_NAME": "XE", "HOST_NAME": "oracle-1"}},
+	waitEventsSQL: {
+		{"WAIT_CLASS": "User I/O", "EVENT": "db file sequential read", "TOTAL_WAITS": "1200", "TIME_WAITED_MICRO": "3000000"},
+		{"WAIT_CLASS": "User I/O", "EVENT": "db file scattered read", "TOTAL_WAITS": "300", "TIME_WAITED_MICRO": "1500000"},
+		{"WAIT_CLASS": "Commit", "EVENT": "log file sync", "TOTAL_WAITS": "50", "TIME_WAITED_MICRO": "2000000"},
+		{"WAIT_CLASS": "Concurrency", "EVENT": "latch: shared pool", "TOTAL_WAITS": "4", "TIME_WAITED_MICRO": "1000"},
+	},
+	sgaMemorySQL: {
+		{"NAME": "Buffer Cache Size", "BYTES": "1073741824"},
+		{"NAME": "Shared Pool Size", "BYTES": "536870912"},
+		{"NAME": "In-Memory Area Size", "BYTES": ""},
+	},
+	pgaMemorySQL: {
+		{"NAME": "total PGA allocated", "VALUE": "209715200"},
+		{"NAME": "maximum PGA allocated", "VALUE": ""},
+	},
+}
+
+func fakeClientProvider(db *sql.DB, s string, logger *zap.Logger) dbClient {
+	return fakeDbClient{Responses: [][]metricRow{queryResponses[s]}}
+}
+
+func newTestScraper(dbProviderFunc dbProviderFunc) *scraper {
+	cfg := createDefaultConfig().(*Config)
+	return &scraper{
+		metricsBuilder:     metadata.NewMetricsBuilder(cfg.MetricsSettings, component.BuildInfo{}),
+		scrapeCfg:          cfg.ScraperControllerSettings,
+		logger:             zap.NewNop(),
+		dbProviderFunc:     dbProviderFunc,
+		clientProviderFunc: fakeClientProvider,
+		now:                time.Now,
+		endpoint:           "localhost:51521/XE",
+	}
+}
+
+func TestScraper_Scrape(t *testing.T) {
+	s := newTestScraper(func(context.Context) (*sql.DB, error) {
+		return &sql.DB{}, nil
+	})
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 20, m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+}
+
+func TestScraper_DisabledMetrics(t *testing.T) {
+	s := newTestScraper(func(context.Context) (*sql.DB, error) {
+		return &sql.DB{}, nil
+	})
+	settings := metadata.DefaultMetricsSettings()
+	settings.OracledbWaitCount.Enabled = false
+	settings.OracledbWaitTime.Enabled = false
+	settings.OracledbCPUTime.Enabled = false
+	s.metricsBuilder = metadata.NewMetricsBuilder(settings, component.BuildInfo{})
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	assert.Equal(t, 17, metrics.Len())
+	for i := 0; i < metrics.Len(); i++ {
+		assert.NotContains(t, []string{"oracledb.wait.count", "oracledb.wait.time", "oracledb.cpu_time"}, metrics.At(i).Name())
+	}
+}
+
+func TestScraper_ResourceAttributes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		instanceErr      error
+		expectedInstance string
+		expectedHost     string
+	}{
+		{
+			name:             "Query",
+			expectedInstance: "XE",
+			expectedHost:     "oracle-1",
+		},
+		{
+			name:             "FallbackToEndpoint",
+			instanceErr:      errors.New("insufficient privileges"),
+			expectedInstance: "localhost:51521/XE",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestScraper(func(context.Context) (*sql.DB, error) {
+				return &sql.DB{}, nil
+			})
+			s.clientProviderFunc = func(db *sql.DB, sql string, logger *zap.Logger) dbClient {
+				if sql == instanceSQL && test.instanceErr != nil {
+					return fakeDbClient{Err: test.instanceErr}
+				}
+				return fakeClientProvider(db, sql, logger)
+			}
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+			m, err := s.scrape(context.Background())
+			require.NoError(t, err)
+
+			attrs := m.ResourceMetrics().At(0).Resource().Attributes()
+			instance, ok := attrs.Get("oracledb.instance.name")
+			require.True(t, ok)
+			assert.Equal(t, test.expectedInstance, instance.Str())
+			host, ok := attrs.Get("host.name")
+			if test.expectedHost == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, test.expectedHost, host.Str())
+		})
+	}
+}
+
+func TestScraper_ScrapeQueryError(t *testing.T) {
+	s := newTestScraper(func(context.Context) (*sql.DB, error) {
+		return &sql.DB{}, nil
+	})
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	s.statsClient = fakeDbClient{Err: errors.New("table or view does not exist")}
+
+	_, err := s.scrape(context.Background())
+	assert.ErrorContains(t, err, "error executing select * from v$sysstat: table or view does not exist")
+}
+
+func TestScraper_Reconnect(t *testing.T) {
+	const failures = 3
+	attempts := 0
+	now := time.Unix(0, 0)
+	s := newTestScraper(func(context.Context) (*sql.DB, error) {
+		attempts++
+		if attempts <= failures {
+			return nil, errors.New("connection refused")
+		}
+		return &sql.DB{}, nil
+	})
+	s.scrapeCfg = scraperhelper.ScraperControllerSettings{CollectionInterval: 3 * time.Second}
+	s.now = func() time.Time { return now }
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+	// start made the first attempt
+	require.Equal(t, 1, attempts)
+	require.Equal(t, initialConnectBackoff, s.connectBackoff)
+
+	// scrapes while waiting for the next attempt don't try to connect
+	_, err := s.scrape(context.Background())
+	assert.ErrorIs(t, err, errNotConnected)
+	assert.Equal(t, 1, attempts)
+
+	now = now.Add(time.Second)
+	_, err = s.scrape(context.Background())
+	assert.ErrorContains(t, err, "failed to open db connection: connection refused")
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 2*time.Second, s.connectBackoff)
+
+	now = now.Add(2 * time.Second)
+	_, err = s.scrape(context.Background())
+	assert.ErrorContains(t, err, "failed to open db connection: connection refused")
+	assert.Equal(t, 3, attempts)
+	// the backoff is capped at the collection interval
+	assert.Equal(t, 3*time.Second, s.connectBackoff)
+
+	now = now.Add(3 * time.Second)
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4, attempts)
+	assert.Equal(t, 20, m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().Len())
+
+	// once connected, scraping doesn't reconnect
+	_, err = s.scrape(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4, attempts)
+}
+
+func TestScraper_WaitEvents(t *testing.T) {
+	testCases := []struct {
+		name           string
+		topWaitClasses int
+		expected       map[string]int64
+	}{
+		{
+			name: "AllWaitClasses",
+			expected: map[string]int64{
+				"User I/O/db file sequential read": 1200,
+				"User I/O/db file scattered read":  300,
+				"Commit/log file sync":             50,
+				"Concurrency/latch: shared pool":   4,
+			},
+		},
+		{
+			name:           "TopWaitClasses",
+			topWaitClasses: 2,
+			expected: map[string]int64{
+				"User I/O/db file sequential read": 1200,
+				"User I/O/db file scattered read":  300,
+				"Commit/log file sync":             50,
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			s := newTestScraper(func(context.Context) (*sql.DB, error) {
+				return &sql.DB{}, nil
+			})
+			s.topWaitClasses = test.topWaitClasses
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+			m, err := s.scrape(context.Background())
+			require.NoError(t, err)
+
+			counts := map[string]int64{}
+			times := map[string]float64{}
+			metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+			for i := 0; i < metrics.Len(); i++ {
+				metric := metrics.At(i)
+				if metric.Name() != "oracledb.wait.count" && metric.Name() != "oracledb.wait.time" {
+					continue
+				}
+				dps := metric.Sum().DataPoints()
+				for j := 0; j < dps.Len(); j++ {
+					dp := dps.At(j)
+					waitClass, ok := dp.Attributes().Get("wait_class")
+					require.True(t, ok)
+					event, ok := dp.Attributes().Get("event")
+					require.True(t, ok)
+					key := waitClass.Str() + "/" + event.Str()
+					if metric.Name() == "oracledb.wait.count" {
+						counts[key] = dp.IntValue()
+					} else {
+						times[key] = dp.DoubleValue()
+					}
+				}
+			}
+			assert.Equal(t, test.expected, counts)
+			assert.Len(t, times, len(test.expected))
+			assert.Equal(t, 3.0, times["User I/O/db file sequential read"])
+		})
+	}
+}
+
+func TestScraper_Memory(t *testing.T) {
+	s := newTestScraper(func(context.Context) (*sql.DB, error) {
+		return &sql.DB{}, nil
+	})
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	values := map[string]map[string]int64{}
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	for i := 0; i < metrics.Len(); i++ {
+		metric := metrics.At(i)
+		if metric.Name() != "oracledb.memory.sga" && metric.Name() != "oracledb.memory.pga" {
+			continue
+		}
+		assert.Equal(t, "By", metric.Unit())
+		values[metric.Name()] = map[string]int64{}
+		dps := metric.Gauge().DataPoints()
+		for j := 0; j < dps.Len(); j++ {
+			dp := dps.At(j)
+			name, ok := dp.Attributes().Get("name")
+			require.True(t, ok)
+			values[metric.Name()][name.Str()] = dp.IntValue()
+		}
+	}
+	// null values are skipped
+	assert.Equal(t, map[string]map[string]int64{
+		"oracledb.memory.sga": {
+			"Buffer Cache Size": 1073741824,
+			"Shared Pool Size":  536870912,
+		},
+		"oracledb.memory.pga": {
+			"total PGA allocated": 209715200,
+		},
+	}, values)
+}
@@ -16,9 +16,12 @@ package windowsperfcountersreceiver // import "github.com/open-telemetry/opentel
 
 import (
 	"fmt"
+	"regexp"
 
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/multierr"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/pkg/winperfcounters"
 )
 
 // Config defines configuration for WindowsPerfCounters receiver.
@@ -27,8 +30,25 @@ type Config struct {
 
 	MetricMetaData map[string]MetricConfig `mapstructure:"metrics"`
 	PerfCounters   []ObjectConfig          `mapstructure:"perfcounters"`
+
+	// FailOnMissingCounters makes the receiver fail to start if any of the configured perf counters
+	// can't be found. Otherwise, missing counters are logged and skipped.
+	FailOnMissingCounters bool `mapstructure:"fail_on_missing_counters"`
+
+	// DisableHostAttributes stops the receiver from adding the host.name and os.type resource
+	// attributes to the emitted metrics.
+	DisableHostAttributes bool `mapstructure:"disable_host_attributes"`
+
+	// MetricNamePrefix is prepended to the name of every emitted metric.
+	MetricNamePrefix string `mapstructure:"metric_name_prefix"`
 }
 
+// metricNamePrefixRegexp matches the characters that are valid in a metric name.
+var metricNamePrefixRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_.\-]*$`)
+
+// machineNameRegexp matches a machine name, optionally given in the \\\\machine form.
+var machineNameRegexp = regexp.MustCompile(`^(\\\\)?[^\\\s]+$`)
+
 // MetricsConfig defines the configuration for a metric to be created.
 type MetricConfig struct {
 	Unit        string      `mapstructure:"unit"`
@@ -45,17 +65,90 @@ type SumMetric struct {
 	Monotonic   bool   `mapstructure:"monotonic"`
 }
 
-// ObjectConfig defines configuration for a perf counter object.
+// ObjectConfig defines configuration for a perf counter object. The object and counter names may
+// be given as numeric indices, which are resolved to the localized names of the host at startup.
 type ObjectConfig struct {
+	// Machine is the name of the remote machine to read the counters from. The counters of the
+	// local machine are read if it's empty.
+	Machine   string          `mapstructure:"machine"`
 	Object    string          `mapstructure:"object"`
 	Instances []string        `mapstructure:"instances"`
 	Counters  []CounterConfig `mapstructure:"counters"`
+	// InstanceFilter filters the scraped instances by name.
+	InstanceFilter InstanceFilter `mapstructure:"instance_filter"`
+}
+
+// InstanceFilter defines regular expressions that instance names are matched against. An instance
+// is dropped if it matches any Exclude expression, or if Include is set and it matches none of them.
+type InstanceFilter struct {
+	Include []string `mapstructure:"include"`
+	Exclude []string `mapstructure:"exclude"`
 }
 
 // CounterConfig defines the individual counter in an object.
 type CounterConfig struct {
 	Name      string `mapstructure:"name"`
 	MetricRep `mapstructure:",squash"`
+	// RawValue emits the raw counter value instead of the formatted value.
+	RawValue bool `mapstructure:"raw_value"`
+}
+
+// instanceMatcher is the compiled form of an InstanceFilter.
+type instanceMatcher struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// compile compiles the filter's expressions. It returns a nil matcher, which matches all
+// instances, if no expressions are set.
+func (f InstanceFilter) compile() (*instanceMatcher, error) {
+	if len(f.Include) == 0 && len(f.Exclude) == 0 {
+		return nil, nil
+	}
+
+	var errs error
+	m := &instanceMatcher{}
+	for _, expr := range f.Include {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid include expression %q: %w", expr, err))
+			continue
+		}
+		m.include = append(m.include, re)
+	}
+	for _, expr := range f.Exclude {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("invalid exclude expression %q: %w", expr, err))
+			continue
+		}
+		m.exclude = append(m.exclude, re)
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return m, nil
+}
+
+// matches reports whether the instance with the given name should be kept.
+func (m *instanceMatcher) matches(instance string) bool {
+	if m == nil {
+		return true
+	}
+	for _, re := range m.exclude {
+		if re.MatchString(instance) {
+			return false
+		}
+	}
+	if len(m.include) == 0 {
+		return true
+	}
+	for _, re := range m.include {
+		if re.MatchString(instance) {
+			return true
+		}
+	}
+	return false
 }
 
 type MetricRep struct {
@@ -70,6 +163,10 @@ func (c *Config) Validate() error {
 		errs = multierr.Append(errs, fmt.Errorf("collection_interval must be a positive duration"))
 	}
 
+	if c.MetricNamePrefix != "" && !metricNamePrefixRegexp.MatchString(c.MetricNamePrefix) {
+		errs = multierr.Append(errs, fmt.Errorf("metric_name_prefix %q must start with a letter and only contain letters, digits, '_', '.' and '-'", c.MetricNamePrefix))
+	}
+
 	if len(c.PerfCounters) == 0 {
 		errs = multierr.Append(errs, fmt.Errorf("must specify at least one perf counter"))
 	}
@@ -97,11 +194,29 @@ func (c *Config) Validate() error {
 			continue
 		}
 
+		if pc.Machine != "" && !machineNameRegexp.MatchString(pc.Machine) {
+			errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q has an invalid machine %q", pc.Object, pc.Machine))
+		}
+
+		_, objectIsIndex, objectErr := winperfcounters.ParseNameIndex(pc.Object)
+		if objectErr != nil {
+			errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q has an invalid object: %w", pc.Object, objectErr))
+		}
+
 		if len(pc.Counters) == 0 {
 			errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q does not specify any counters", pc.Object))
 		}
 
 		for _, counter := range pc.Counters {
+			_, counterIsIndex, err := winperfcounters.ParseNameIndex(counter.Name)
+			switch {
+			case err != nil:
+				errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q includes an invalid counter: %w", pc.Object, err))
+			case objectErr == nil && counterIsIndex != objectIsIndex:
+				// Indices resolve to localized names, which can't be combined with English names in a counter path.
+				errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q includes counter %q, but the object and its counters must either all be indices or all be names", pc.Object, counter.Name))
+			}
+
 			if counter.MetricRep.Name == "" {
 				continue
 			}
@@ -117,6 +232,10 @@ func (c *Config) Validate() error {
 			}
 		}
 
+		if _, err := pc.InstanceFilter.compile(); err != nil {
+			errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q has an invalid instance filter: %w", pc.Object, err))
+		}
+
 		for _, instance := range pc.Instances {
 			if instance == "" {
 				errs = multierr.Append(errs, fmt.Errorf("perf counter for object %q includes an empty instance", pc.Object))
@@ -33,6 +33,14 @@ const (
 	noObjectNameErr               = "must specify object name for all perf counters"
 	noCountersErr                 = `perf counter for object "%s" does not specify any counters`
 	emptyInstanceErr              = `perf counter for object "%s" includes an empty instance`
+	invalidInstanceFilterErr      = `perf counter for object "%s" has an invalid instance filter: %s`
+	invalidObjectIndexErr         = `perf counter for object "%s" has an invalid object: "%s" is not a valid performance counter index`
+	invalidCounterIndexErr        = `perf counter for object "%s" includes an invalid counter: "%s" is not a valid performance counter index`
+	mixedIndexAndNameErr          = `perf counter for object "%s" includes counter "%s", but the object and its counters must either all be indices or all be names`
+	invalidMetricNamePrefixErr    = `metric_name_prefix "%s" must start with a letter and only contain letters, digits, '_', '.' and '-'`
+	invalidMachineErr             = `perf counter for object "%s" has an invalid machine "%s"`
+	invalidAggregationErr         = `sum metric "%s" includes an invalid aggregation`
+	undefinedMetricErr            = `perf counter for object "%s" includes an undefined metric`
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -189,6 +197,165 @@ func TestLoadConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "rawvalue"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:   "object",
+						Counters: []CounterConfig{{Name: "counter1", MetricRep: MetricRep{Name: "metric"}, RawValue: true}},
+					},
+				},
+				MetricMetaData: map[string]MetricConfig{
+					"metric": {
+						Description: "desc",
+						Unit:        "1",
+						Gauge:       GaugeMetric{},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "index"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:   "238",
+						Counters: []CounterConfig{{Name: "6", MetricRep: MetricRep{Name: "metric"}}},
+					},
+				},
+				MetricMetaData: map[string]MetricConfig{
+					"metric": {
+						Description: "desc",
+						Unit:        "1",
+						Gauge:       GaugeMetric{},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "failonmissingcounters"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:   "object",
+						Counters: []CounterConfig{{Name: "counter1"}},
+					},
+				},
+				FailOnMissingCounters: true,
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "disablehostattributes"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:   "object",
+						Counters: []CounterConfig{{Name: "counter1"}},
+					},
+				},
+				DisableHostAttributes: true,
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "metricnameprefix"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:   "object",
+						Counters: []CounterConfig{{Name: "counter1"}},
+					},
+				},
+				MetricNamePrefix: "windows.",
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "machine"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Machine:  "server-1",
+						Object:   "object",
+						Counters: []CounterConfig{{Name: "counter1"}},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "instancefilterinclude"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:         "Process",
+						Instances:      []string{"*"},
+						InstanceFilter: InstanceFilter{Include: []string{"^otelcol", "^svchost$"}},
+						Counters:       []CounterConfig{{Name: "Working Set"}},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "instancefilterexclude"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:         "Process",
+						Instances:      []string{"*"},
+						InstanceFilter: InstanceFilter{Exclude: []string{"^Idle$"}},
+						Counters:       []CounterConfig{{Name: "Working Set"}},
+					},
+				},
+			},
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "instancefiltercombined"),
+			expected: &Config{
+				ScraperControllerSettings: scraperhelper.ScraperControllerSettings{
+					ReceiverSettings:   config.NewReceiverSettings(config.NewComponentID(typeStr)),
+					CollectionInterval: 60 * time.Second,
+				},
+				PerfCounters: []ObjectConfig{
+					{
+						Object:         "Process",
+						Instances:      []string{"*"},
+						InstanceFilter: InstanceFilter{Include: []string{"^svchost"}, Exclude: []string{"#[0-9]+$"}},
+						Counters:       []CounterConfig{{Name: "Working Set"}},
+					},
+				},
+			},
+		},
 		{
 			id:          config.NewComponentIDWithName(typeStr, "negative-collection-interval"),
 			expectedErr: negativeCollectionIntervalErr,
@@ -219,6 +386,31 @@ func TestLoadConfig(t *testing.T) {
 			id:          config.NewComponentIDWithName(typeStr, "emptyinstance"),
 			expectedErr: fmt.Sprintf(emptyInstanceErr, "object"),
 		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "invalidinstancefilter"),
+			expectedErr: fmt.Sprintf(
+				invalidInstanceFilterErr,
+				"Process",
+				"invalid include expression \"(\": error parsing regexp: missing closing ): `(`; "+
+					"invalid exclude expression \"[a-\": error parsing regexp: missing closing ]: `[a-`",
+			),
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "invalidmetricnameprefix"),
+			expectedErr: fmt.Sprintf(invalidMetricNamePrefixErr, "1windows/"),
+		},
+		{
+			id:          config.NewComponentIDWithName(typeStr, "invalidmachine"),
+			expectedErr: fmt.Sprintf(invalidMachineErr, "object", `server\\1`),
+		},
+		{
+			id: config.NewComponentIDWithName(typeStr, "invalidindex"),
+			expectedErr: fmt.Sprintf(
+				"%s; %s",
+				fmt.Sprintf(invalidObjectIndexErr, "0", "0"),
+				fmt.Sprintf(invalidCounterIndexErr, "0", "99999999999"),
+			),
+		},
 	}
 
 	for _, tt := range tests {
@@ -239,3 +431,127 @@ func TestLoadConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigValidate(t *testing.T) {
+	validConfig := func() *Config {
+		return &Config{
+			ScraperControllerSettings: scraperhelper.ScraperControllerSettings{CollectionInterval: time.Minute},
+			MetricMetaData: map[string]MetricConfig{
+				"metric": {Description: "desc", Unit: "1", Gauge: GaugeMetric{}},
+			},
+			PerfCounters: []ObjectConfig{
+				{Object: "object", Counters: []CounterConfig{{Name: "counter", MetricRep: MetricRep{Name: "metric"}}}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(cfg *Config)
+		expectedErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(cfg *Config) {},
+		},
+		{
+			name:        "negativeCollectionInterval",
+			modify:      func(cfg *Config) { cfg.CollectionInterval = -time.Second },
+			expectedErr: negativeCollectionIntervalErr,
+		},
+		{
+			name:        "invalidMetricNamePrefix",
+			modify:      func(cfg *Config) { cfg.MetricNamePrefix = "1windows/" },
+			expectedErr: fmt.Sprintf(invalidMetricNamePrefixErr, "1windows/"),
+		},
+		{
+			name:        "noPerfCounters",
+			modify:      func(cfg *Config) { cfg.PerfCounters = nil },
+			expectedErr: noPerfCountersErr,
+		},
+		{
+			name: "invalidAggregation",
+			modify: func(cfg *Config) {
+				cfg.MetricMetaData["metric"] = MetricConfig{Sum: SumMetric{Aggregation: "invalid"}}
+			},
+			expectedErr: fmt.Sprintf(invalidAggregationErr, "metric"),
+		},
+		{
+			name:        "noObjectName",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Object = "" },
+			expectedErr: noObjectNameErr,
+		},
+		{
+			name:        "invalidMachine",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Machine = "server 1" },
+			expectedErr: fmt.Sprintf(invalidMachineErr, "object", "server 1"),
+		},
+		{
+			name:        "invalidObjectIndex",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Object = "0" },
+			expectedErr: fmt.Sprintf(invalidObjectIndexErr, "0", "0"),
+		},
+		{
+			name:        "noCounters",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Counters = nil },
+			expectedErr: fmt.Sprintf(noCountersErr, "object"),
+		},
+		{
+			name:        "invalidCounterIndex",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Counters[0].Name = "99999999999" },
+			expectedErr: fmt.Sprintf(invalidCounterIndexErr, "object", "99999999999"),
+		},
+		{
+			name:        "objectIndexWithCounterName",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Object = "238" },
+			expectedErr: fmt.Sprintf(mixedIndexAndNameErr, "238", "counter"),
+		},
+		{
+			name:        "objectNameWithCounterIndex",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Counters[0].Name = "6" },
+			expectedErr: fmt.Sprintf(mixedIndexAndNameErr, "object", "6"),
+		},
+		{
+			name:        "undefinedMetric",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Counters[0].MetricRep.Name = "undefined" },
+			expectedErr: fmt.Sprintf(undefinedMetricErr, "object"),
+		},
+		{
+			name: "invalidInstanceFilter",
+			modify: func(cfg *Config) {
+				cfg.PerfCounters[0].InstanceFilter = InstanceFilter{Include: []string{"("}}
+			},
+			expectedErr: fmt.Sprintf(
+				invalidInstanceFilterErr,
+				"object",
+				"invalid include expression \"(\": error parsing regexp: missing closing ): `(`",
+			),
+		},
+		{
+			name:        "emptyInstance",
+			modify:      func(cfg *Config) { cfg.PerfCounters[0].Instances = []string{""} },
+			expectedErr: fmt.Sprintf(emptyInstanceErr, "object"),
+		},
+		{
+			name: "multipleErrors",
+			modify: func(cfg *Config) {
+				cfg.CollectionInterval = 0
+				cfg.PerfCounters = append(cfg.PerfCounters, ObjectConfig{})
+			},
+			expectedErr: fmt.Sprintf("%s; %s", negativeCollectionIntervalErr, noObjectNameErr),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(cfg)
+
+			if tt.expectedErr != "" {
+				assert.EqualError(t, cfg.Validate(), tt.expectedErr)
+				return
+			}
+			assert.NoError(t, cfg.Validate())
+		})
+	}
+}
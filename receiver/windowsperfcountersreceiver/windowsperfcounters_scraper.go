@@ -19,11 +19,16 @@ package windowsperfcountersreceiver // import "github.com/open-telemetry/opentel
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/collector/component"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
+	conventions "go.opentelemetry.io/collector/semconv/v1.6.1"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 
@@ -32,33 +37,88 @@ import (
 
 const instanceLabelName = "instance"
 
+// osHostname is the function used to get the value of the host.name resource attribute, replaced when testing.
+var osHostname = os.Hostname
+
 type perfCounterMetricWatcher struct {
 	winperfcounters.PerfCounterWatcher
 	MetricRep
+
+	// filter drops the scraped values of instances that are filtered out.
+	filter *instanceMatcher
+
+	// failing is set while scraping the watcher keeps failing, so the failure is only logged once per streak.
+	failing bool
+
+	// machine is the remote machine of the counter, empty for the local machine.
+	machine string
 }
 
-type newWatcherFunc func(string, string, string) (winperfcounters.PerfCounterWatcher, error)
+type newWatcherFunc func(string, string, string, string) (winperfcounters.PerfCounterWatcher, error)
 
 // scraper is the type that scrapes various host metrics.
 type scraper struct {
 	cfg      *Config
 	settings component.TelemetrySettings
 	watchers []perfCounterMetricWatcher
+	// hostName is the value of the host.name resource attribute, if it could be determined.
+	hostName string
 
 	// for mocking
-	newWatcher newWatcherFunc
+	newWatcher     newWatcherFunc
+	newRawWatcher  newWatcherFunc
+	connectMachine func(string) error
 }
 
 func newScraper(cfg *Config, settings component.TelemetrySettings) *scraper {
-	return &scraper{cfg: cfg, settings: settings, newWatcher: winperfcounters.NewWatcher}
+	return &scraper{
+		cfg:            cfg,
+		settings:       settings,
+		newWatcher:     winperfcounters.NewRemoteWatcher,
+		newRawWatcher:  winperfcounters.NewRemoteRawWatcher,
+		connectMachine: winperfcounters.ConnectMachine,
+	}
 }
 
 func (s *scraper) start(context.Context, component.Host) error {
+	if err := s.connectMachines(); err != nil {
+		return err
+	}
+
 	watchers, err := s.initWatchers()
 	if err != nil {
+		if s.cfg.FailOnMissingCounters {
+			for _, watcher := range watchers {
+				err = multierr.Append(err, watcher.Close())
+			}
+			return fmt.Errorf("some performance counters could not be initialized: %w", err)
+		}
 		s.settings.Logger.Warn("some performance counters could not be initialized", zap.Error(err))
 	}
 	s.watchers = watchers
+
+	if !s.cfg.DisableHostAttributes {
+		hostName, err := osHostname()
+		if err != nil {
+			s.settings.Logger.Warn("failed to get the hostname, the host.name resource attribute won't be set", zap.Error(err))
+		}
+		s.hostName = hostName
+	}
+	return nil
+}
+
+// connectMachines checks that the remote machines of the configured perf counters can be reached.
+func (s *scraper) connectMachines() error {
+	connected := map[string]bool{}
+	for _, objCfg := range s.cfg.PerfCounters {
+		if objCfg.Machine == "" || connected[objCfg.Machine] {
+			continue
+		}
+		if err := s.connectMachine(objCfg.Machine); err != nil {
+			return fmt.Errorf("remote machine %q is unreachable: %w", objCfg.Machine, err)
+		}
+		connected[objCfg.Machine] = true
+	}
 	return nil
 }
 
@@ -67,9 +127,19 @@ func (s *scraper) initWatchers() ([]perfCounterMetricWatcher, error) {
 	var watchers []perfCounterMetricWatcher
 
 	for _, objCfg := range s.cfg.PerfCounters {
+		filter, err := objCfg.InstanceFilter.compile()
+		if err != nil {
+			errs = multierr.Append(errs, err)
+			continue
+		}
+
 		for _, instance := range instancesFromConfig(objCfg) {
 			for _, counterCfg := range objCfg.Counters {
-				pcw, err := s.newWatcher(objCfg.Object, instance, counterCfg.Name)
+				newWatcher := s.newWatcher
+				if counterCfg.RawValue {
+					newWatcher = s.newRawWatcher
+				}
+				pcw, err := newWatcher(objCfg.Machine, objCfg.Object, instance, counterCfg.Name)
 				if err != nil {
 					errs = multierr.Append(errs, err)
 					continue
@@ -78,6 +148,8 @@ func (s *scraper) initWatchers() ([]perfCounterMetricWatcher, error) {
 				watcher := perfCounterMetricWatcher{
 					PerfCounterWatcher: pcw,
 					MetricRep:          MetricRep{Name: pcw.Path()},
+					filter:             filter,
+					machine:            objCfg.Machine,
 				}
 				if counterCfg.MetricRep.Name != "" {
 					watcher.MetricRep.Name = counterCfg.MetricRep.Name
@@ -105,51 +177,108 @@ func (s *scraper) shutdown(context.Context) error {
 	return errs
 }
 
-func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
-	md := pmetric.NewMetrics()
-	metricSlice := md.ResourceMetrics().AppendEmpty().ScopeMetrics().AppendEmpty().Metrics()
-	now := pcommon.NewTimestampFromTime(time.Now())
-	var errs error
+// resourceMetrics are the metrics of the counters of a machine.
+type resourceMetrics struct {
+	metricSlice pmetric.MetricSlice
+	// metrics are the metrics configured in metric_metadata, by name.
+	metrics map[string]pmetric.Metric
+}
+
+// newResourceMetrics appends the resource of the metrics of the given machine, the local machine if
+// it's empty, to md.
+func (s *scraper) newResourceMetrics(md pmetric.Metrics, machine string) *resourceMetrics {
+	rm := md.ResourceMetrics().AppendEmpty()
+	if !s.cfg.DisableHostAttributes {
+		hostName := s.hostName
+		if machine != "" {
+			hostName = strings.TrimPrefix(machine, `\\`)
+		}
+		if hostName != "" {
+			rm.Resource().Attributes().PutStr(conventions.AttributeHostName, hostName)
+		}
+		rm.Resource().Attributes().PutStr(conventions.AttributeOSType, conventions.AttributeOSTypeWindows)
+	}
+	return &resourceMetrics{
+		metricSlice: rm.ScopeMetrics().AppendEmpty().Metrics(),
+		metrics:     map[string]pmetric.Metric{},
+	}
+}
 
-	metricSlice.EnsureCapacity(len(s.watchers))
-	metrics := map[string]pmetric.Metric{}
-	for name, metricCfg := range s.cfg.MetricMetaData {
-		builtMetric := metricSlice.AppendEmpty()
+// configuredMetric returns the metric configured in metric_metadata with the given name, appending
+// it to the metrics of the resource if it isn't yet.
+func (s *scraper) configuredMetric(res *resourceMetrics, name string) (pmetric.Metric, bool) {
+	if metric, ok := res.metrics[name]; ok {
+		return metric, true
+	}
+	metricCfg, ok := s.cfg.MetricMetaData[name]
+	if !ok {
+		return pmetric.Metric{}, false
+	}
+	builtMetric := res.metricSlice.AppendEmpty()
 
-		builtMetric.SetName(name)
-		builtMetric.SetDescription(metricCfg.Description)
-		builtMetric.SetUnit(metricCfg.Unit)
+	builtMetric.SetName(s.cfg.MetricNamePrefix + name)
+	builtMetric.SetDescription(metricCfg.Description)
+	builtMetric.SetUnit(metricCfg.Unit)
 
-		if (metricCfg.Sum != SumMetric{}) {
-			builtMetric.SetEmptySum().SetIsMonotonic(metricCfg.Sum.Monotonic)
+	if (metricCfg.Sum != SumMetric{}) {
+		builtMetric.SetEmptySum().SetIsMonotonic(metricCfg.Sum.Monotonic)
 
-			switch metricCfg.Sum.Aggregation {
-			case "cumulative":
-				builtMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
-			case "delta":
-				builtMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
-			}
-		} else {
-			builtMetric.SetEmptyGauge()
+		switch metricCfg.Sum.Aggregation {
+		case "cumulative":
+			builtMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+		case "delta":
+			builtMetric.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityDelta)
 		}
+	} else {
+		builtMetric.SetEmptyGauge()
+	}
+
+	res.metrics[name] = builtMetric
+	return builtMetric, true
+}
 
-		metrics[name] = builtMetric
+func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
+	md := pmetric.NewMetrics()
+	now := pcommon.NewTimestampFromTime(time.Now())
+	var errs scrapererror.ScrapeErrors
+
+	// The metrics of the local machine have their own resource, always present and holding all the
+	// configured metrics. The metrics of each remote machine are added to a resource of the machine,
+	// so that they are attributed to it rather than to the host of the collector.
+	local := s.newResourceMetrics(md, "")
+	local.metricSlice.EnsureCapacity(len(s.watchers))
+	for name := range s.cfg.MetricMetaData {
+		s.configuredMetric(local, name)
 	}
+	resources := map[string]*resourceMetrics{"": local}
 
-	for _, watcher := range s.watchers {
+	for i := range s.watchers {
+		watcher := &s.watchers[i]
 		counterVals, err := watcher.ScrapeData()
 		if err != nil {
-			errs = multierr.Append(errs, err)
+			if !watcher.failing {
+				s.settings.Logger.Warn("failed to scrape performance counter", zap.String("path", watcher.Path()), zap.Error(err))
+				watcher.failing = true
+			}
+			errs.AddPartial(1, err)
 			continue
 		}
+		watcher.failing = false
 
 		for _, val := range counterVals {
-			var metric pmetric.Metric
-			if builtmetric, ok := metrics[watcher.MetricRep.Name]; ok {
-				metric = builtmetric
-			} else {
-				metric = metricSlice.AppendEmpty()
-				metric.SetName(watcher.MetricRep.Name)
+			if !watcher.filter.matches(val.InstanceName) {
+				continue
+			}
+
+			res, ok := resources[watcher.machine]
+			if !ok {
+				res = s.newResourceMetrics(md, watcher.machine)
+				resources[watcher.machine] = res
+			}
+			metric, ok := s.configuredMetric(res, watcher.MetricRep.Name)
+			if !ok {
+				metric = res.metricSlice.AppendEmpty()
+				metric.SetName(s.cfg.MetricNamePrefix + watcher.MetricRep.Name)
 				metric.SetUnit("1")
 				metric.SetEmptyGauge()
 			}
@@ -157,7 +286,7 @@ func (s *scraper) scrape(context.Context) (pmetric.Metrics, error) {
 			initializeMetricDps(metric, now, val, watcher.MetricRep.Attributes)
 		}
 	}
-	return md, errs
+	return md, errs.Combine()
 }
 
 func initializeMetricDps(metric pmetric.Metric, now pcommon.Timestamp, counterValue winperfcounters.CounterValue,
@@ -19,6 +19,8 @@ package windowsperfcountersreceiver
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"go.opentelemetry.io/collector/component/componenttest"
 	"go.opentelemetry.io/collector/pdata/pcommon"
 	"go.opentelemetry.io/collector/pdata/pmetric"
+	"go.opentelemetry.io/collector/receiver/scrapererror"
 	"go.opentelemetry.io/collector/receiver/scraperhelper"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -59,11 +62,21 @@ func (w *mockPerfCounter) Close() error {
 }
 
 func mockPerfCounterFactory(mpc mockPerfCounter) newWatcherFunc {
-	return func(string, string, string) (winperfcounters.PerfCounterWatcher, error) {
+	return func(string, string, string, string) (winperfcounters.PerfCounterWatcher, error) {
 		return &mpc, nil
 	}
 }
 
+func mockPerfCounterFactoryByName(mpcs map[string]*mockPerfCounter) newWatcherFunc {
+	return func(_, object, _, counterName string) (winperfcounters.PerfCounterWatcher, error) {
+		mpc, ok := mpcs[counterName]
+		if !ok {
+			return nil, fmt.Errorf("failed to create perf counter with path \\%s\\%s: counter not found", object, counterName)
+		}
+		return mpc, nil
+	}
+}
+
 func Test_WindowsPerfCounterScraper(t *testing.T) {
 	type testCase struct {
 		name string
@@ -78,6 +91,10 @@ func Test_WindowsPerfCounterScraper(t *testing.T) {
 
 	defaultConfig := createDefaultConfig().(*Config)
 
+	hostname := osHostname
+	osHostname = func() (string, error) { return "test-host", nil }
+	defer func() { osHostname = hostname }()
+
 	testCases := []testCase{
 		{
 			name: "Standard",
@@ -270,7 +287,7 @@ func TestInitWatchers(t *testing.T) {
 
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
-			s := &scraper{cfg: &Config{PerfCounters: test.cfgs}, newWatcher: winperfcounters.NewWatcher}
+			s := &scraper{cfg: &Config{PerfCounters: test.cfgs}, newWatcher: winperfcounters.NewRemoteWatcher}
 			watchers, errs := s.initWatchers()
 			if test.expectedErr != "" {
 				require.EqualError(t, errs, test.expectedErr)
@@ -284,6 +301,366 @@ func TestInitWatchers(t *testing.T) {
 	}
 }
 
+func TestInitWatchersRawValue(t *testing.T) {
+	formatted := mockPerfCounter{path: "formatted", counterValues: []winperfcounters.CounterValue{{Value: 1.5}}}
+	raw := mockPerfCounter{path: "raw", counterValues: []winperfcounters.CounterValue{{Value: 12345}}}
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{
+				Object: "Memory",
+				Counters: []CounterConfig{
+					{Name: "Committed Bytes", MetricRep: MetricRep{Name: "formatted"}},
+					{Name: "Committed Bytes", MetricRep: MetricRep{Name: "raw"}, RawValue: true},
+				},
+			},
+		},
+	}
+	s := &scraper{cfg: cfg, newWatcher: mockPerfCounterFactory(formatted), newRawWatcher: mockPerfCounterFactory(raw)}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 2, metrics.Len())
+	values := map[string]float64{}
+	for i := 0; i < metrics.Len(); i++ {
+		values[metrics.At(i).Name()] = metrics.At(i).Gauge().DataPoints().At(0).DoubleValue()
+	}
+	assert.Equal(t, map[string]float64{"formatted": 1.5, "raw": 12345}, values)
+}
+
+func TestStartMissingCounters(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		failOnMissingCounters bool
+		expectedErr           string
+		expectedWatchers      int
+	}{
+		{
+			name:             "WarnAndSkip",
+			expectedWatchers: 1,
+		},
+		{
+			name:                  "FailFast",
+			failOnMissingCounters: true,
+			expectedErr:           "some performance counters could not be initialized: failed to create perf counter with path \\Memory\\Missing Counter: counter not found",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				PerfCounters: []ObjectConfig{
+					{
+						Object: "Memory",
+						Counters: []CounterConfig{
+							{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}},
+							{Name: "Missing Counter", MetricRep: MetricRep{Name: "missing"}},
+						},
+					},
+				},
+				FailOnMissingCounters: test.failOnMissingCounters,
+			}
+
+			core, obs := observer.New(zapcore.WarnLevel)
+			settings := componenttest.NewNopTelemetrySettings()
+			settings.Logger = zap.New(core)
+			s := &scraper{
+				cfg:        cfg,
+				settings:   settings,
+				newWatcher: mockPerfCounterFactoryByName(map[string]*mockPerfCounter{"Committed Bytes": {path: "\\Memory\\Committed Bytes"}}),
+			}
+
+			err := s.start(context.Background(), componenttest.NewNopHost())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				assert.Equal(t, 0, obs.Len())
+				assert.Empty(t, s.watchers)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, obs.Len())
+			assert.Equal(t, "some performance counters could not be initialized", obs.All()[0].Message)
+			assert.Len(t, s.watchers, test.expectedWatchers)
+		})
+	}
+}
+
+func TestStartRemoteMachine(t *testing.T) {
+	testCases := []struct {
+		name        string
+		connectErr  error
+		expectedErr string
+	}{
+		{
+			name: "Reachable",
+		},
+		{
+			name:        "Unreachable",
+			connectErr:  errors.New("failed to connect to machine server-1: Unable to connect to the specified computer, or the computer is offline."),
+			expectedErr: `remote machine "server-1" is unreachable: failed to connect to machine server-1: Unable to connect to the specified computer, or the computer is offline.`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := &Config{
+				PerfCounters: []ObjectConfig{
+					{Machine: "server-1", Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes"}}},
+					{Machine: "server-1", Object: "Memory", Counters: []CounterConfig{{Name: "Available Bytes"}}},
+				},
+			}
+
+			var connected, machines []string
+			s := &scraper{
+				cfg:      cfg,
+				settings: componenttest.NewNopTelemetrySettings(),
+				newWatcher: func(machine, object, _, counterName string) (winperfcounters.PerfCounterWatcher, error) {
+					machines = append(machines, machine)
+					return &mockPerfCounter{path: "\\\\" + machine + "\\" + object + "\\" + counterName}, nil
+				},
+				connectMachine: func(machine string) error {
+					connected = append(connected, machine)
+					return test.connectErr
+				},
+			}
+
+			err := s.start(context.Background(), componenttest.NewNopHost())
+			// each machine is only connected to once
+			assert.Equal(t, []string{"server-1"}, connected)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				assert.Empty(t, machines)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"server-1", "server-1"}, machines)
+			require.Len(t, s.watchers, 2)
+			assert.Equal(t, "\\\\server-1\\Memory\\Committed Bytes", s.watchers[0].Name)
+		})
+	}
+}
+
+func TestScrapeInstanceFilter(t *testing.T) {
+	mpc := mockPerfCounter{
+		path: "\\Process(*)\\Working Set",
+		counterValues: []winperfcounters.CounterValue{
+			{InstanceName: "otelcol-contrib", Value: 1},
+			{InstanceName: "svchost", Value: 2},
+			{InstanceName: "svchost#1", Value: 3},
+			{InstanceName: "Idle", Value: 4},
+		},
+	}
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{
+				Object:    "Process",
+				Instances: []string{"*"},
+				InstanceFilter: InstanceFilter{
+					Include: []string{"^otelcol", "^svchost"},
+					Exclude: []string{"#[0-9]+$"},
+				},
+				Counters: []CounterConfig{{Name: "Working Set", MetricRep: MetricRep{Name: "process.memory"}}},
+			},
+		},
+	}
+	s := &scraper{cfg: cfg, newWatcher: mockPerfCounterFactory(mpc)}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	require.Equal(t, 1, metrics.Len())
+	dps := metrics.At(0).Gauge().DataPoints()
+	var instances []string
+	for i := 0; i < dps.Len(); i++ {
+		instance, ok := dps.At(i).Attributes().Get(instanceLabelName)
+		require.True(t, ok)
+		instances = append(instances, instance.Str())
+	}
+	assert.Equal(t, []string{"otelcol-contrib", "svchost"}, instances)
+}
+
+func TestScrapePartialError(t *testing.T) {
+	failing := &mockPerfCounter{path: "\\Broken\\Counter", scrapeErr: errors.New("object not found")}
+	working := &mockPerfCounter{path: "\\Memory\\Committed Bytes", counterValues: []winperfcounters.CounterValue{{Value: 1.0}}}
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{Object: "Broken", Counters: []CounterConfig{{Name: "Counter", MetricRep: MetricRep{Name: "broken"}}}},
+			{Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}}}},
+		},
+	}
+
+	core, obs := observer.New(zapcore.WarnLevel)
+	settings := componenttest.NewNopTelemetrySettings()
+	settings.Logger = zap.New(core)
+	s := &scraper{
+		cfg:        cfg,
+		settings:   settings,
+		newWatcher: mockPerfCounterFactoryByName(map[string]*mockPerfCounter{"Counter": failing, "Committed Bytes": working}),
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	for i := 0; i < 2; i++ {
+		m, err := s.scrape(context.Background())
+		require.Error(t, err)
+		assert.True(t, scrapererror.IsPartialScrapeError(err))
+		assert.ErrorContains(t, err, "object not found")
+
+		metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+		require.Equal(t, 1, metrics.Len())
+		assert.Equal(t, "bytes.committed", metrics.At(0).Name())
+		assert.Equal(t, 1, metrics.At(0).Gauge().DataPoints().Len())
+	}
+	require.Equal(t, 1, obs.Len())
+	assert.Equal(t, "failed to scrape performance counter", obs.All()[0].Message)
+	assert.Equal(t, failing.path, obs.All()[0].ContextMap()["path"])
+
+	// A recovered counter is logged again once it starts failing again.
+	failing.scrapeErr = nil
+	_, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	failing.scrapeErr = errors.New("object not found")
+	_, err = s.scrape(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 2, obs.Len())
+}
+
+func TestScrapeMetricNamePrefix(t *testing.T) {
+	cfg := &Config{
+		MetricMetaData: map[string]MetricConfig{
+			"bytes.committed": {Description: "number of bytes committed to memory", Unit: "By"},
+		},
+		PerfCounters: []ObjectConfig{
+			{Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}}}},
+			{Object: "Memory", Counters: []CounterConfig{{Name: "Available Bytes"}}},
+		},
+		MetricNamePrefix: "windows.",
+	}
+	s := &scraper{
+		cfg:      cfg,
+		settings: componenttest.NewNopTelemetrySettings(),
+		newWatcher: mockPerfCounterFactoryByName(map[string]*mockPerfCounter{
+			"Committed Bytes": {path: "\\Memory\\Committed Bytes", counterValues: []winperfcounters.CounterValue{{Value: 1.0}}},
+			"Available Bytes": {path: "\\Memory\\Available Bytes", counterValues: []winperfcounters.CounterValue{{Value: 2.0}}},
+		}),
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+
+	metrics := m.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	var names []string
+	for i := 0; i < metrics.Len(); i++ {
+		names = append(names, metrics.At(i).Name())
+	}
+	assert.ElementsMatch(t, []string{"windows.bytes.committed", "windows.\\Memory\\Available Bytes"}, names)
+}
+
+func TestScrapeHostAttributes(t *testing.T) {
+	hostname := osHostname
+	defer func() { osHostname = hostname }()
+
+	testCases := []struct {
+		name                  string
+		disableHostAttributes bool
+		hostnameErr           error
+		expected              map[string]interface{}
+	}{
+		{
+			name:     "Enabled",
+			expected: map[string]interface{}{"host.name": "test-host", "os.type": "windows"},
+		},
+		{
+			name:        "HostnameError",
+			hostnameErr: errors.New("no hostname"),
+			expected:    map[string]interface{}{"os.type": "windows"},
+		},
+		{
+			name:                  "Disabled",
+			disableHostAttributes: true,
+			expected:              map[string]interface{}{},
+		},
+	}
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			osHostname = func() (string, error) {
+				if test.hostnameErr != nil {
+					return "", test.hostnameErr
+				}
+				return "test-host", nil
+			}
+			mpc := mockPerfCounter{counterValues: []winperfcounters.CounterValue{{Value: 1.0}}}
+			cfg := &Config{
+				PerfCounters: []ObjectConfig{
+					{Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}}}},
+				},
+				DisableHostAttributes: test.disableHostAttributes,
+			}
+			s := &scraper{cfg: cfg, settings: componenttest.NewNopTelemetrySettings(), newWatcher: mockPerfCounterFactory(mpc)}
+			require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+			m, err := s.scrape(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, m.ResourceMetrics().Len())
+			assert.Equal(t, test.expected, m.ResourceMetrics().At(0).Resource().Attributes().AsRaw())
+		})
+	}
+}
+
+func TestScrapeRemoteMachineHostAttributes(t *testing.T) {
+	hostname := osHostname
+	defer func() { osHostname = hostname }()
+	osHostname = func() (string, error) { return "test-host", nil }
+
+	mpc := mockPerfCounter{counterValues: []winperfcounters.CounterValue{{Value: 1.0}}}
+	cfg := &Config{
+		PerfCounters: []ObjectConfig{
+			{Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}}}},
+			{Machine: `\\server-1`, Object: "Memory", Counters: []CounterConfig{{Name: "Committed Bytes", MetricRep: MetricRep{Name: "bytes.committed"}}}},
+			{Machine: "server-2", Object: "Memory", Counters: []CounterConfig{{Name: "Available Bytes", MetricRep: MetricRep{Name: "bytes.available"}}}},
+		},
+		MetricMetaData: map[string]MetricConfig{
+			"bytes.committed": {Unit: "By", Gauge: GaugeMetric{}},
+			"bytes.available": {Unit: "By", Gauge: GaugeMetric{}},
+		},
+	}
+	s := &scraper{
+		cfg:            cfg,
+		settings:       componenttest.NewNopTelemetrySettings(),
+		newWatcher:     mockPerfCounterFactory(mpc),
+		connectMachine: func(string) error { return nil },
+	}
+	require.NoError(t, s.start(context.Background(), componenttest.NewNopHost()))
+
+	m, err := s.scrape(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, m.ResourceMetrics().Len())
+
+	expected := []struct {
+		hostName string
+		metrics  map[string]int
+	}{
+		{hostName: "test-host", metrics: map[string]int{"bytes.committed": 1, "bytes.available": 0}},
+		{hostName: "server-1", metrics: map[string]int{"bytes.committed": 1}},
+		{hostName: "server-2", metrics: map[string]int{"bytes.available": 1}},
+	}
+	for i, exp := range expected {
+		rm := m.ResourceMetrics().At(i)
+		assert.Equal(t, map[string]interface{}{"host.name": exp.hostName, "os.type": "windows"}, rm.Resource().Attributes().AsRaw())
+
+		metrics := rm.ScopeMetrics().At(0).Metrics()
+		dps := map[string]int{}
+		for j := 0; j < metrics.Len(); j++ {
+			dps[metrics.At(j).Name()] = metrics.At(j).Gauge().DataPoints().Len()
+		}
+		assert.Equal(t, exp.metrics, dps)
+	}
+}
+
 func TestScrape(t *testing.T) {
 	testCases := []struct {
 		name              string